@@ -1,6 +1,8 @@
 package ubiq
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"golang.org/x/exp/slices"
 )
@@ -11,6 +13,48 @@ const (
 
 var defaultAlphabet, _ = NewAlphabet(defaultAlphabetStr)
 
+// commonly used alphabets. the radix of each (the number
+// of characters in it) is noted alongside it
+var (
+	// radix 10
+	DigitsAlphabet, _ = NewAlphabet("0123456789")
+	// radix 16
+	HexLowerAlphabet, _ = NewAlphabet("0123456789abcdef")
+	// radix 16
+	HexUpperAlphabet, _ = NewAlphabet("0123456789ABCDEF")
+	// radix 26
+	UpperAlphabet, _ = NewAlphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	// radix 26
+	LowerAlphabet, _ = NewAlphabet("abcdefghijklmnopqrstuvwxyz")
+	// radix 62; this is the default alphabet
+	AlphanumericAlphabet, _ = NewAlphabet(defaultAlphabetStr)
+	// radix 32; Crockford's base32, which excludes I, L, O and U
+	Base32CrockfordAlphabet, _ = NewAlphabet(
+		"0123456789ABCDEFGHJKMNPQRSTVWXYZ")
+	// radix 64; the url- and filename-safe base64 alphabet of RFC 4648
+	Base64URLAlphabet, _ = NewAlphabet(
+		"ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz" +
+			"0123456789-_")
+	// radix 95; the space and all visible ASCII characters
+	PrintableASCIIAlphabet, _ = NewAlphabet(runeRanges(' ', '~'))
+	// radix 114; the ASCII letters followed by the letters of
+	// ISO 8859-1 from U+00C0 to U+00FF, excluding × and ÷
+	Latin1LettersAlphabet, _ = NewAlphabet(runeRanges(
+		'A', 'Z', 'a', 'z', 0xc0, 0xd6, 0xd8, 0xf6, 0xf8, 0xff))
+)
+
+// return a string consisting of the runes in the inclusive
+// ranges specified by each pair of arguments, in order
+func runeRanges(r ...rune) string {
+	var s []rune
+	for i := 0; i+1 < len(r); i += 2 {
+		for c := r[i]; c <= r[i+1]; c++ {
+			s = append(s, c)
+		}
+	}
+	return string(s)
+}
+
 type letter struct {
 	val rune
 	pos int
@@ -41,7 +85,7 @@ func NewAlphabet(s string) (Alphabet, error) {
 		})
 
 	for i := 1; i < len(self.by_val); i++ {
-		if self.by_val[i] == self.by_val[i-1] {
+		if self.by_val[i].val == self.by_val[i-1].val {
 			return Alphabet{}, errors.New(
 				"duplicate letters found in alphabet")
 		}
@@ -76,3 +120,35 @@ func (self *Alphabet) PosOf(c rune) int {
 func (self *Alphabet) ValAt(i int) rune {
 	return self.by_pos[i]
 }
+
+// return the characters of the alphabet, in order, as a string
+func (self *Alphabet) String() string {
+	return string(self.by_pos)
+}
+
+// MarshalText encodes the alphabet as its characters, in order.
+// this also allows alphabets to be encoded as JSON strings
+func (self Alphabet) MarshalText() ([]byte, error) {
+	return []byte(string(self.by_pos)), nil
+}
+
+// UnmarshalText decodes an alphabet from its characters, as
+// produced by MarshalText. the alphabet must not contain duplicates
+func (self *Alphabet) UnmarshalText(text []byte) error {
+	a, err := NewAlphabet(string(text))
+	if err != nil {
+		return err
+	}
+
+	*self = a
+	return nil
+}
+
+// return the hexadecimal encoding of the SHA-256 hash of the UTF-8
+// encoding of the characters of the alphabet, in order. alphabets
+// containing the same characters in different orders produce
+// different ciphertexts and have different fingerprints
+func (self *Alphabet) Fingerprint() string {
+	h := sha256.Sum256([]byte(string(self.by_pos)))
+	return hex.EncodeToString(h[:])
+}
@@ -0,0 +1,198 @@
+package ubiq
+
+import (
+	"errors"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/exp/slices"
+)
+
+// an inclusive range of runes. the portion of the
+// range outside of [0, unicode.MaxRune] is ignored
+type RuneRange struct {
+	Lo, Hi rune
+}
+
+// groups of characters that are easily mistaken for one another,
+// each ordered from most to least preferred. this list is part of
+// the definition of ExcludeConfusables; changing it changes the
+// alphabets produced by builders that use it
+var confusables = []string{
+	"0OoΟοОо",
+	"1Il|ΙІӀ",
+	"2Zz",
+	"5SsЅѕ",
+	"8BΒВ",
+	"AΑА", "CСϹ", "EΕЕ", "HΗН", "JЈ", "KΚК", "MΜМ", "NΝ",
+	"PΡР", "TΤТ", "XΧХ", "YΥҮ",
+	"aа", "cсϲ", "eе", "iі", "jј", "pр", "xх", "yу",
+}
+
+// Builder for alphabets made up of arbitrary sets of characters.
+// regardless of the order in which characters are added, the
+// resulting alphabet is always in ascending code point order, so
+// that the same set of characters always yields the same alphabet
+type AlphabetBuilder struct {
+	// sorted, without duplicates
+	set []rune
+}
+
+// Allocate a new alphabet builder
+//
+// @args describe the initial set of characters and are
+// interpreted as they are by the Add function
+func NewAlphabetBuilder(args ...interface{}) (*AlphabetBuilder, error) {
+	this := new(AlphabetBuilder)
+	if err := this.Add(args...); err != nil {
+		return nil, err
+	}
+	return this, nil
+}
+
+// return the characters described by @arg
+func builderRunes(arg interface{}) ([]rune, error) {
+	var s []rune
+
+	switch v := arg.(type) {
+	case rune:
+		s = append(s, v)
+	case string:
+		s = []rune(v)
+	case RuneRange:
+		if v.Lo > v.Hi {
+			return nil, errors.New("invalid rune range")
+		}
+
+		// values outside of the unicode range would be discarded
+		// below anyway. limiting the range to valid values avoids
+		// generating them and keeps the loop from overflowing
+		lo, hi := v.Lo, v.Hi
+		if lo < 0 {
+			lo = 0
+		}
+		if hi > unicode.MaxRune {
+			hi = unicode.MaxRune
+		}
+		for r := lo; r <= hi; r++ {
+			s = append(s, r)
+		}
+	case *unicode.RangeTable:
+		for _, r16 := range v.R16 {
+			for r := rune(r16.Lo); r <= rune(r16.Hi); r += rune(r16.Stride) {
+				s = append(s, r)
+			}
+		}
+		for _, r32 := range v.R32 {
+			for r := rune(r32.Lo); r <= rune(r32.Hi); r += rune(r32.Stride) {
+				s = append(s, r)
+			}
+		}
+	case Alphabet:
+		s = append(s, v.by_pos...)
+	case *Alphabet:
+		s = append(s, v.by_pos...)
+	case *AlphabetBuilder:
+		s = append(s, v.set...)
+	default:
+		return nil, errors.New("unsupported alphabet specifier")
+	}
+
+	// surrogates and values beyond the unicode
+	// range can't be represented in a string
+	n := 0
+	for _, r := range s {
+		if utf8.ValidRune(r) {
+			s[n] = r
+			n++
+		}
+	}
+
+	return s[:n], nil
+}
+
+// Add characters to the set. each argument may be:
+//   - a rune
+//   - a string, each character of which is added
+//   - a RuneRange
+//   - a *unicode.RangeTable, e.g. unicode.Hangul
+//   - an Alphabet (or a pointer to one)
+//   - an *AlphabetBuilder, forming the union of the two sets
+//
+// surrogate code points are ignored. if any argument
+// is invalid, the set is left unchanged
+func (this *AlphabetBuilder) Add(args ...interface{}) error {
+	set := this.set
+	for _, arg := range args {
+		s, err := builderRunes(arg)
+		if err != nil {
+			return err
+		}
+		set = append(set, s...)
+	}
+
+	slices.Sort(set)
+	this.set = slices.Compact(set)
+
+	return nil
+}
+
+// Remove characters from the set. the arguments are interpreted
+// as they are by Add; an *AlphabetBuilder removes all of its
+// characters, forming the difference of the two sets
+func (this *AlphabetBuilder) Remove(args ...interface{}) error {
+	var rem []rune
+	for _, arg := range args {
+		s, err := builderRunes(arg)
+		if err != nil {
+			return err
+		}
+		rem = append(rem, s...)
+	}
+	slices.Sort(rem)
+
+	set := this.set[:0]
+	for _, r := range this.set {
+		if _, ok := slices.BinarySearch(rem, r); !ok {
+			set = append(set, r)
+		}
+	}
+	this.set = set
+
+	return nil
+}
+
+// Remove characters that could be mistaken for other characters in
+// the set. for each group of confusable characters (e.g. 0, O, and
+// o), only the most preferred one present in the set is kept
+func (this *AlphabetBuilder) ExcludeConfusables() {
+	var rem []interface{}
+
+	for _, g := range confusables {
+		keep := false
+		for _, r := range g {
+			if _, ok := slices.BinarySearch(this.set, r); ok {
+				if keep {
+					rem = append(rem, r)
+				}
+				keep = true
+			}
+		}
+	}
+
+	this.Remove(rem...)
+}
+
+// return the number of characters in the set
+func (this *AlphabetBuilder) Len() int {
+	return len(this.set)
+}
+
+// Build an alphabet from the set, in ascending code point order
+func (this *AlphabetBuilder) Build() (Alphabet, error) {
+	if len(this.set) < 2 {
+		return Alphabet{}, errors.New("alphabet contains too few letters")
+	}
+
+	return NewAlphabet(string(this.set))
+}
@@ -0,0 +1,141 @@
+package ubiq
+
+import (
+	"math"
+	"testing"
+	"unicode"
+)
+
+func TestAlphabetBuilder(t *testing.T) {
+	b, err := NewAlphabetBuilder(
+		"zyx", 'a', RuneRange{'0', '9'}, &UpperAlphabet)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.String() != "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZaxyz" {
+		t.Fatal(a.String())
+	}
+
+	// the same set, specified differently and in a
+	// different order, produces the same alphabet
+	c, _ := NewAlphabetBuilder(UpperAlphabet, "axyz", "9876543210", "A")
+	if d, _ := c.Build(); d.String() != a.String() {
+		t.Fatal(d.String())
+	}
+
+	if err := b.Remove(RuneRange{'A', 'Y'}, "x"); err != nil {
+		t.Fatal(err)
+	}
+	if a, _ := b.Build(); a.String() != "0123456789Zayz" {
+		t.Fatal(a.String())
+	}
+
+	// difference and union of builders
+	c.Remove(b)
+	if a, _ := c.Build(); a.String() != "ABCDEFGHIJKLMNOPQRSTUVWXYx" {
+		t.Fatal(a.String())
+	}
+	c.Add(b)
+	if c.Len() != 40 {
+		t.Fatal(c.Len())
+	}
+
+	if err := b.Add(RuneRange{'z', 'a'}); err == nil {
+		t.Fatal("invalid range accepted")
+	}
+	if err := b.Add(1.0); err == nil {
+		t.Fatal("invalid specifier accepted")
+	}
+	if b.Len() != 14 {
+		t.Fatal(b.Len())
+	}
+
+	b, _ = NewAlphabetBuilder(RuneRange{0xd7fe, 0xe001})
+	if b.Len() != 4 {
+		t.Fatal(b.Len())
+	}
+
+	// ranges extending beyond the valid runes
+	// are limited to them
+	b, _ = NewAlphabetBuilder(RuneRange{unicode.MaxRune - 1, math.MaxInt32})
+	if b.Len() != 2 {
+		t.Fatal(b.Len())
+	}
+	b, _ = NewAlphabetBuilder(RuneRange{math.MinInt32, 1})
+	if b.Len() != 2 {
+		t.Fatal(b.Len())
+	}
+	if _, err := NewAlphabetBuilder(
+		RuneRange{unicode.MaxRune + 1, math.MaxInt32}); err != nil {
+		t.Fatal(err)
+	}
+
+	b, _ = NewAlphabetBuilder("a")
+	if _, err := b.Build(); err == nil {
+		t.Fatal("alphabet of one letter built")
+	}
+}
+
+func TestAlphabetBuilderTable(t *testing.T) {
+	b, err := NewAlphabetBuilder(unicode.Hangul)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.Remove(unicode.Hangul)
+	if b.Len() != 0 {
+		t.Fatal(b.Len())
+	}
+
+	// the hangul syllables block
+	b.Add(RuneRange{0xac00, 0xd7a3})
+	a, err := b.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Len() != 11172 || a.ValAt(0) != '가' {
+		t.Fatal(a.Len())
+	}
+
+	b, _ = NewAlphabetBuilder(unicode.Cyrillic)
+	b.Remove(RuneRange{0, unicode.MaxRune}, unicode.Cyrillic)
+	if b.Len() != 0 {
+		t.Fatal(b.Len())
+	}
+
+	b, _ = NewAlphabetBuilder(unicode.Cyrillic)
+	a, _ = b.Build()
+	for _, r := range "АяЁ" {
+		if a.PosOf(r) < 0 {
+			t.Fatal(string(r))
+		}
+	}
+}
+
+func TestAlphabetBuilderConfusables(t *testing.T) {
+	b, _ := NewAlphabetBuilder(DigitsAlphabet, UpperAlphabet, LowerAlphabet)
+	b.ExcludeConfusables()
+
+	a, _ := b.Build()
+	for _, r := range "OoIlZzSsB" {
+		if a.PosOf(r) >= 0 {
+			t.Fatal(string(r))
+		}
+	}
+	for _, r := range "0125Xxab" {
+		if a.PosOf(r) < 0 {
+			t.Fatal(string(r))
+		}
+	}
+
+	// a lone member of a group is kept
+	b, _ = NewAlphabetBuilder(LowerAlphabet, "АВС")
+	b.ExcludeConfusables()
+	if b.Len() != 29 {
+		t.Fatal(b.Len())
+	}
+}
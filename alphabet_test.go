@@ -0,0 +1,178 @@
+package ubiq
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAlphabetCatalogue(t *testing.T) {
+	for _, c := range []struct {
+		alpha *Alphabet
+		radix int
+	}{
+		{&DigitsAlphabet, 10},
+		{&HexLowerAlphabet, 16},
+		{&HexUpperAlphabet, 16},
+		{&UpperAlphabet, 26},
+		{&LowerAlphabet, 26},
+		{&AlphanumericAlphabet, 62},
+		{&Base32CrockfordAlphabet, 32},
+		{&Base64URLAlphabet, 64},
+		{&PrintableASCIIAlphabet, 95},
+		{&Latin1LettersAlphabet, 114},
+	} {
+		if c.alpha.Len() != c.radix {
+			t.Fatalf("%q: radix %d != %d",
+				c.alpha.String(), c.alpha.Len(), c.radix)
+		}
+
+		for i := 0; i < c.alpha.Len(); i++ {
+			if c.alpha.PosOf(c.alpha.ValAt(i)) != i {
+				t.Fatalf("%q: position %d", c.alpha.String(), i)
+			}
+		}
+	}
+
+	for _, r := range "ILOU" {
+		if Base32CrockfordAlphabet.PosOf(r) >= 0 {
+			t.Fatal(string(r))
+		}
+	}
+	for _, r := range "×÷" {
+		if Latin1LettersAlphabet.PosOf(r) >= 0 {
+			t.Fatal(string(r))
+		}
+	}
+	if !AlphanumericAlphabet.IsDef() {
+		t.Fatal("alphanumeric alphabet is not the default")
+	}
+}
+
+func TestAlphabetArgument(t *testing.T) {
+	K := []byte{
+		0x2b, 0x7e, 0x15, 0x16, 0x28, 0xae, 0xd2, 0xa6,
+		0xab, 0xf7, 0x15, 0x88, 0x09, 0xcf, 0x4f, 0x3c,
+	}
+	PT := "0123456789ABCDEF"
+
+	var out []string
+	for _, arg := range []interface{}{
+		HexUpperAlphabet.String(),
+		HexUpperAlphabet,
+		&HexUpperAlphabet,
+	} {
+		ff1, err := NewFF1(K, nil, 0, 0, 16, arg)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		CT, err := ff1.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		out = append(out, CT)
+	}
+
+	if out[0] != out[1] || out[0] != out[2] {
+		t.Fatal(out)
+	}
+
+	if _, err := NewFF1(K, nil, 0, 0, 10, 10); err == nil {
+		t.Fatal("invalid alphabet argument accepted")
+	}
+}
+
+func TestAlphabetMarshal(t *testing.T) {
+	b, err := Latin1LettersAlphabet.MarshalText()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var a Alphabet
+	if err := a.UnmarshalText(b); err != nil {
+		t.Fatal(err)
+	}
+	if a.String() != Latin1LettersAlphabet.String() {
+		t.Fatal(a.String())
+	}
+
+	if err := a.UnmarshalText([]byte("abca")); err == nil {
+		t.Fatal("alphabet with duplicates unmarshaled")
+	}
+
+	type config struct {
+		Alpha Alphabet `json:"alpha"`
+	}
+
+	j, err := json.Marshal(config{Alpha: Base32CrockfordAlphabet})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(j) != `{"alpha":"0123456789ABCDEFGHJKMNPQRSTVWXYZ"}` {
+		t.Fatal(string(j))
+	}
+
+	var c config
+	if err := json.Unmarshal(j, &c); err != nil {
+		t.Fatal(err)
+	}
+	if c.Alpha.String() != Base32CrockfordAlphabet.String() ||
+		c.Alpha.PosOf('Z') != 31 {
+		t.Fatal(c.Alpha.String())
+	}
+}
+
+func TestAlphabetFingerprint(t *testing.T) {
+	// sha256("0123456789")
+	if DigitsAlphabet.Fingerprint() !=
+		"84d89877f0d4041efb6bf91a16f0248f"+
+			"2fd573e6af05c19f96bedb9f882f7882" {
+		t.Fatal(DigitsAlphabet.Fingerprint())
+	}
+
+	a, _ := NewAlphabet("1023456789")
+	if a.Fingerprint() == DigitsAlphabet.Fingerprint() {
+		t.Fatal("reordered alphabet has the same fingerprint")
+	}
+
+	K := []byte{
+		0x2b, 0x7e, 0x15, 0x16, 0x28, 0xae, 0xd2, 0xa6,
+		0xab, 0xf7, 0x15, 0x88, 0x09, 0xcf, 0x4f, 0x3c,
+	}
+
+	// only the portion of the alphabet covered
+	// by the radix is included in the fingerprint
+	if _, err := NewFF1(K, nil, 0, 0, 10,
+		AlphanumericAlphabet, DigitsAlphabet.Fingerprint()); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFF3_1(K, make([]byte, 7), 10,
+		DigitsAlphabet, DigitsAlphabet.Fingerprint()); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewFF1(K, nil, 0, 0, 10,
+		a, DigitsAlphabet.Fingerprint()); err == nil {
+		t.Fatal("fingerprint mismatch not detected")
+	}
+	if _, err := NewFF3_1(K, make([]byte, 7), 10,
+		a, DigitsAlphabet.Fingerprint()); err == nil {
+		t.Fatal("fingerprint mismatch not detected")
+	}
+}
+
+func TestAlphabetDuplicates(t *testing.T) {
+	if _, err := NewAlphabet("0123456789a0"); err == nil {
+		t.Fatal("alphabet with duplicates created")
+	}
+
+	// duplicates beyond the radix are not used
+	if _, err := NewFF1(make([]byte, 16), nil, 0, 0, 11,
+		"0123456789a0"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewFF1(make([]byte, 16), nil, 0, 0, 12,
+		"0123456789a0"); err == nil {
+		t.Fatal("context with duplicate letters created")
+	}
+}
@@ -0,0 +1,336 @@
+package ubiq
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// Checksum is the interface implemented by check digit schemes
+type Checksum interface {
+	// Len returns the number of check characters that
+	// follow the payload
+	Len() int
+	// Compute returns the check characters for the payload
+	// @P, or false if @P contains invalid characters or if
+	// no valid check characters exist for it
+	Compute(P []rune) ([]rune, bool)
+}
+
+// convert a string of decimal digits into their values
+func digitsOf(P []rune) ([]int, bool) {
+	D := make([]int, len(P))
+	for i, r := range P {
+		if r < '0' || r > '9' {
+			return nil, false
+		}
+		D[i] = int(r - '0')
+	}
+	return D, true
+}
+
+// compute the luhn sum of the digits in @D, where each digit is
+// a value between 0 and 9. the result is 0 for a valid number
+func luhnSum(D []int) int {
+	s := 0
+	for i := range D {
+		d := D[len(D)-1-i]
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		s += d
+	}
+	return s % 10
+}
+
+// Luhn is the mod-10 scheme used by payment cards and IMEIs
+type Luhn struct{}
+
+func (Luhn) Len() int {
+	return 1
+}
+
+func (Luhn) Compute(P []rune) ([]rune, bool) {
+	D, ok := digitsOf(P)
+	if !ok {
+		return nil, false
+	}
+
+	// compute the sum with a check digit of 0
+	// and choose the digit that makes it 0
+	D = append(D, 0)
+	return []rune{rune('0' + (10-luhnSum(D))%10)}, true
+}
+
+// GS1 is the mod-10 scheme used by EAN, UPC and ISBN-13 codes
+type GS1 struct{}
+
+func (GS1) Len() int {
+	return 1
+}
+
+func (GS1) Compute(P []rune) ([]rune, bool) {
+	D, ok := digitsOf(P)
+	if !ok {
+		return nil, false
+	}
+
+	// weights alternate between 3 and 1,
+	// starting with the rightmost digit
+	s := 0
+	for i := range D {
+		w := 1
+		if i%2 == 0 {
+			w = 3
+		}
+		s += w * D[len(D)-1-i]
+	}
+
+	return []rune{rune('0' + (10-s%10)%10)}, true
+}
+
+var verhoeffD = [10][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+var verhoeffP = [8][10]int{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+var verhoeffInv = [10]int{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+
+// Verhoeff is the dihedral group scheme used by, for
+// example, India's Aadhaar numbers
+type Verhoeff struct{}
+
+func (Verhoeff) Len() int {
+	return 1
+}
+
+func (Verhoeff) Compute(P []rune) ([]rune, bool) {
+	D, ok := digitsOf(P)
+	if !ok {
+		return nil, false
+	}
+
+	c := 0
+	for i := range D {
+		// the check digit occupies position 0, so the
+		// payload digits start at position 1
+		c = verhoeffD[c][verhoeffP[(i+1)%8][D[len(D)-1-i]]]
+	}
+
+	return []rune{rune('0' + verhoeffInv[c])}, true
+}
+
+var dammTable = [10][10]int{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+// Damm is the quasigroup scheme described by H. Michael Damm
+type Damm struct{}
+
+func (Damm) Len() int {
+	return 1
+}
+
+func (Damm) Compute(P []rune) ([]rune, bool) {
+	D, ok := digitsOf(P)
+	if !ok {
+		return nil, false
+	}
+
+	c := 0
+	for _, d := range D {
+		c = dammTable[c][d]
+	}
+
+	return []rune{rune('0' + c)}, true
+}
+
+// Mod11 is the weighted modulus 11 scheme used by ISBN-10 codes
+// and many bank account numbers. The payload digits are weighted,
+// starting with the rightmost, by 2, 3, ... up to MaxWeight, after
+// which the weights start again at 2. The check value is the one
+// that makes the weighted sum (with the check weighted by 1) a
+// multiple of 11.
+//
+// If X is true, a check value of 10 is represented by 'X'.
+// Otherwise, payloads requiring a check value of 10 are invalid.
+//
+// For ISBN-10, use Mod11{MaxWeight: 10, X: true}. Many account
+// numbering schemes use Mod11{MaxWeight: 7}
+type Mod11 struct {
+	MaxWeight int
+	X         bool
+}
+
+func (Mod11) Len() int {
+	return 1
+}
+
+func (this Mod11) Compute(P []rune) ([]rune, bool) {
+	D, ok := digitsOf(P)
+	if !ok || this.MaxWeight < 2 {
+		return nil, false
+	}
+
+	s := 0
+	for i := range D {
+		s += (2 + i%(this.MaxWeight-1)) * D[len(D)-1-i]
+	}
+
+	c := (11 - s%11) % 11
+	if c < 10 {
+		return []rune{rune('0' + c)}, true
+	} else if this.X {
+		return []rune{'X'}, true
+	}
+
+	return nil, false
+}
+
+// Context structure for encrypting numbers that end in check
+// characters such that the result carries valid check characters
+type CheckDigit struct {
+	ff1 *FF1
+	sum Checksum
+}
+
+// Allocate a new check digit context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// @sum is the scheme used to compute the check characters, which
+// follow the payload digits at the end of the number. any spaces
+// or dashes in the number are preserved
+func NewCheckDigit(ff1 *FF1, sum Checksum) (*CheckDigit, error) {
+	if sum.Len() < 1 {
+		return nil, errors.New("invalid checksum length")
+	}
+
+	return &CheckDigit{ff1: ff1, sum: sum}, nil
+}
+
+func (this *CheckDigit) cipher(X []rune, T []byte, enc bool) (
+	[]rune, error) {
+	var pos []int
+	for i, r := range X {
+		if r != ' ' && r != '-' {
+			pos = append(pos, i)
+		}
+	}
+
+	k := len(pos) - this.sum.Len()
+	if k < 1 {
+		return nil, errors.New("invalid text length")
+	}
+
+	P := make([]rune, len(pos))
+	for i, p := range pos {
+		P[i] = X[p]
+	}
+
+	C, ok := this.sum.Compute(P[:k])
+	if !ok || string(C) != string(P[k:]) {
+		return nil, errors.New("invalid check digit")
+	}
+
+	if _, ok := digitsOf(P[:k]); !ok {
+		return nil, errors.New("invalid character in input")
+	}
+
+	n, _ := new(big.Int).SetString(string(P[:k]), 10)
+	m := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(k)), nil)
+
+	// walk through the payloads, skipping those
+	// for which no check characters exist
+	for {
+		var err error
+
+		n, err = this.ff1.cycleWalk(n, m, T, enc)
+		if err != nil {
+			return nil, err
+		}
+
+		s := n.Text(10)
+		copy(P, []rune(strings.Repeat("0", k-len(s))+s))
+
+		C, ok = this.sum.Compute(P[:k])
+		if ok {
+			copy(P[k:], C)
+			break
+		}
+	}
+
+	Y := make([]rune, len(X))
+	copy(Y, X)
+	for i, p := range pos {
+		Y[p] = P[i]
+	}
+
+	return Y, nil
+}
+
+func (this *CheckDigit) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt a number @X, including its check characters,
+// with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *CheckDigit) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *CheckDigit) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt a number @X, including its check characters,
+// with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *CheckDigit) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
@@ -0,0 +1,109 @@
+package ubiq
+
+import (
+	"testing"
+)
+
+func testChecksum(t *testing.T, sum Checksum, valid ...string) {
+	for _, s := range valid {
+		X := []rune(s)
+		k := len(X) - sum.Len()
+
+		C, ok := sum.Compute(X[:k])
+		if !ok || string(C) != string(X[k:]) {
+			t.Fatal(s, string(C))
+		}
+	}
+}
+
+func TestChecksumLuhn(t *testing.T) {
+	testChecksum(t, Luhn{},
+		"4111111111111111", "79927398713", "490154203237518")
+}
+
+func TestChecksumGS1(t *testing.T) {
+	testChecksum(t, GS1{},
+		"4006381333931", "036000291452", "9780306406157", "96385074")
+}
+
+func TestChecksumVerhoeff(t *testing.T) {
+	testChecksum(t, Verhoeff{}, "2363", "123451", "14200")
+}
+
+func TestChecksumDamm(t *testing.T) {
+	testChecksum(t, Damm{}, "5724")
+}
+
+func TestChecksumMod11(t *testing.T) {
+	testChecksum(t, Mod11{MaxWeight: 10, X: true},
+		"0306406152", "080442957X", "0198526636")
+
+	if _, ok := (Mod11{MaxWeight: 10}).Compute([]rune("080442957")); ok {
+		t.FailNow()
+	}
+}
+
+func testCheckDigit(t *testing.T, sum Checksum, inputs ...string) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cd, err := NewCheckDigit(ff1, sum)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range inputs {
+		CT, err := cd.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the output must itself be valid
+		if _, err := cd.Decrypt(CT, []byte("other")); err != nil {
+			t.Fatal(err)
+		}
+
+		out, err := cd.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+
+		// corrupt the check digit
+		X := []rune(PT)
+		if X[len(X)-1] == '1' {
+			X[len(X)-1] = '2'
+		} else {
+			X[len(X)-1] = '1'
+		}
+		if _, err := cd.Encrypt(string(X), nil); err == nil {
+			t.Fatal(string(X))
+		}
+	}
+}
+
+func TestCheckDigitIMEI(t *testing.T) {
+	testCheckDigit(t, Luhn{}, "490154203237518", "35-209900-176148-1")
+}
+
+func TestCheckDigitEAN(t *testing.T) {
+	testCheckDigit(t, GS1{}, "4006381333931", "978-0-306-40615-7")
+}
+
+func TestCheckDigitVerhoeff(t *testing.T) {
+	testCheckDigit(t, Verhoeff{}, "2345 6789 0124")
+}
+
+func TestCheckDigitDamm(t *testing.T) {
+	testCheckDigit(t, Damm{}, "12345678906")
+}
+
+func TestCheckDigitMod11(t *testing.T) {
+	testCheckDigit(t, Mod11{MaxWeight: 10, X: true},
+		"0-306-40615-2", "080442957X")
+	testCheckDigit(t, Mod11{MaxWeight: 7}, "12345678903")
+}
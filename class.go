@@ -0,0 +1,314 @@
+package ubiq
+
+import (
+	"encoding/binary"
+	"sort"
+	"sync"
+
+	"golang.org/x/exp/slices"
+)
+
+// the ASCII character classes preserved by the ClassPreserving
+// context. their indices are used to identify them
+var charClasses = []Alphabet{
+	DigitsAlphabet, UpperAlphabet, LowerAlphabet,
+}
+
+// identifiers of the classes of non-ASCII characters. digits are
+// identified by the code point of their zero, and letters by the
+// unicode plane in which they lie, the index of their script, and
+// their case. keeping letters within their plane prevents, e.g.,
+// common accented letters from being encrypted to rarely supported
+// supplementary characters
+const (
+	classDigits  = 1 << 22
+	classLetters = 2 << 22
+)
+
+// a run of consecutive characters, from @lo to @hi
+// inclusive, belonging to the same class
+type classRun struct {
+	lo, hi rune
+	class  int
+}
+
+// the table of classes (classTable in class_tables.go) is generated
+// from a fixed version of unicode rather than from the tables of the
+// toolchain, which change from one release to the next, so that the
+// classes, and therefore ciphertexts, do not change with them
+//
+//go:generate go run gen_classes.go
+
+// alphabets of the non-ASCII classes, by identifier,
+// built as the classes are encountered
+var classAlphabets sync.Map
+
+// Context structure for encrypting free-form strings such that
+// digits remain digits, upper case letters remain upper case,
+// lower case letters remain lower case, and all other characters
+// (punctuation, whitespace, etc.) are left untouched
+type ClassPreserving struct {
+	ff1 *FF1
+}
+
+// Allocate a new class-preserving context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used. the tweak
+// used for each class is the specified (or default) tweak followed
+// by an encoding of the layout of the classes within the input, so
+// the context should allow tweaks of arbitrary length
+//
+// ASCII digits, upper case letters and lower case letters each form
+// a class. outside of ASCII, each set of ten decimal digits forms a
+// class, as do the upper and lower case letters of each cased script
+// (Latin, Greek, Cyrillic, etc.), so that, e.g., "ë" is encrypted to
+// another lower case Latin letter outside of ASCII. letters without
+// case, such as those of Chinese, Arabic or Hebrew, and title case
+// letters are passed through unencrypted along with punctuation and
+// whitespace. the classes are those of unicode 13.0, regardless of
+// the version of go; characters assigned by later versions are also
+// passed through
+//
+// classes containing enough characters to satisfy the minimum
+// length requirements of FF1 are each encrypted on their own.
+// the characters of any remaining classes are encrypted together
+// as a single mixed-radix value. note that very short inputs
+// (e.g. a lone digit) form very small domains, which are
+// easy to guess
+func NewClassPreserving(ff1 *FF1) (*ClassPreserving, error) {
+	return &ClassPreserving{ff1: ff1}, nil
+}
+
+// determine the class of @c, returning -1 if
+// the character does not belong to any class
+func classOf(c rune) int {
+	if c < 0x80 {
+		for i := range charClasses {
+			if charClasses[i].PosOf(c) >= 0 {
+				return i
+			}
+		}
+		return -1
+	}
+
+	i := sort.Search(len(classTable), func(i int) bool {
+		return classTable[i].hi >= c
+	})
+	if i < len(classTable) && classTable[i].lo <= c {
+		return classTable[i].class
+	}
+
+	return -1
+}
+
+// return the alphabet of the class @c
+func classAlphabet(c int) *Alphabet {
+	if c < len(charClasses) {
+		return &charClasses[c]
+	}
+
+	if a, ok := classAlphabets.Load(c); ok {
+		return a.(*Alphabet)
+	}
+
+	var args []interface{}
+	for _, r := range classTable {
+		if r.class == c {
+			args = append(args, RuneRange{r.lo, r.hi})
+		}
+	}
+
+	b, _ := NewAlphabetBuilder(args...)
+	a, _ := b.Build()
+	classAlphabets.Store(c, &a)
+
+	return &a
+}
+
+// encode the identity of the class @c, or of
+// passthrough characters if @c is -1, for the tweak
+func classTag(c int) []byte {
+	if c < len(charClasses) {
+		return []byte{byte(c)}
+	}
+
+	buf := make([]byte, 1+binary.MaxVarintLen64)
+	buf[0] = 0xfe
+	return buf[:1+binary.PutUvarint(buf[1:], uint64(c))]
+}
+
+// build the tweak for the input @X from the caller's tweak @T.
+// the class layout is encoded as runs, each of which is the
+// class (or 0xff for passthrough characters) followed by the
+// length of the run. the class being encrypted is appended
+// by the caller
+func (this *ClassPreserving) tweak(X []rune, T []byte) []byte {
+	if T == nil {
+		T = this.ff1.ctx.twk
+	}
+
+	twk := append(make([]byte, 0, len(T)+8), T...)
+	buf := make([]byte, binary.MaxVarintLen64)
+
+	for i := 0; i < len(X); {
+		c := classOf(X[i])
+
+		j := i + 1
+		for j < len(X) && classOf(X[j]) == c {
+			j++
+		}
+
+		twk = append(twk, classTag(c)...)
+		twk = append(twk, buf[:binary.PutUvarint(buf, uint64(j-i))]...)
+
+		i = j
+	}
+
+	return twk
+}
+
+func (this *ClassPreserving) cipher(X []rune, T []byte, enc bool) (
+	[]rune, error) {
+	ctx := this.ff1.ctx
+
+	Y := make([]rune, len(X))
+	copy(Y, X)
+
+	twk := this.tweak(X, T)
+
+	// positions of characters belonging to classes with too
+	// few characters to be encrypted on their own
+	var short []int
+
+	// the positions of the characters of each class present
+	// in the input, with the classes in ascending order
+	classes := make(map[int][]int)
+	var order []int
+	for i, r := range X {
+		if c := classOf(r); c >= 0 {
+			if _, ok := classes[c]; !ok {
+				order = append(order, c)
+			}
+			classes[c] = append(classes[c], i)
+		}
+	}
+	slices.Sort(order)
+
+	for _, c := range order {
+		alpha := classAlphabet(c)
+		pos := classes[c]
+
+		if len(pos) < minTextLen(alpha.Len()) {
+			short = append(short, pos...)
+			continue
+		}
+
+		R := make([]rune, len(pos))
+		for i, p := range pos {
+			R[i] = X[p]
+		}
+
+		n := len(R)
+		u := n / 2
+		v := n - u
+
+		RunesToBigInt(ctx.nA, alpha, R[:u])
+		RunesToBigInt(ctx.nB, alpha, R[u:])
+
+		err := this.ff1.cipherInts(alpha.Len(), n, minTextLen(alpha.Len()),
+			append(twk, classTag(c)...), enc)
+		if err != nil {
+			return nil, err
+		}
+
+		R = append(
+			BigIntToRunes(alpha, ctx.nA, u),
+			BigIntToRunes(alpha, ctx.nB, v)...)
+		for i, p := range pos {
+			Y[p] = R[i]
+		}
+	}
+
+	if len(short) > 0 {
+		err := this.cipherShort(X, Y, short,
+			append(twk, byte(len(charClasses))), enc)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return Y, nil
+}
+
+// the characters at positions @pos within @X, drawn from classes
+// that are too short to be encrypted individually, are encrypted
+// jointly as a single mixed-radix value, and the results are
+// placed in the same positions within @Y
+func (this *ClassPreserving) cipherShort(X, Y []rune, pos []int,
+	T []byte, enc bool) error {
+	var args []interface{}
+	for _, p := range pos {
+		args = append(args, classAlphabet(classOf(X[p])))
+	}
+
+	mr, err := NewMixedRadix(this.ff1, args...)
+	if err != nil {
+		return err
+	}
+
+	R := make([]rune, len(pos))
+	for i, p := range pos {
+		R[i] = X[p]
+	}
+
+	n, err := mr.rank(R)
+	if err == nil {
+		n, err = this.ff1.cycleWalk(n, mr.dom, T, enc)
+	}
+	if err != nil {
+		return err
+	}
+
+	for i, r := range mr.unrank(n) {
+		Y[pos[i]] = r
+	}
+
+	return nil
+}
+
+func (this *ClassPreserving) EncryptRunes(X []rune, T []byte) (
+	[]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt a string @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *ClassPreserving) Encrypt(X string, T []byte) (
+	Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *ClassPreserving) DecryptRunes(X []rune, T []byte) (
+	[]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt a string @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *ClassPreserving) Decrypt(X string, T []byte) (
+	Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
@@ -0,0 +1,1286 @@
+// Code generated by "go run gen_classes.go". DO NOT EDIT.
+
+package ubiq
+
+// the version of unicode from which the class table was generated
+const classUnicodeVersion = "13.0.0"
+
+// the classes of non-ASCII characters, as runs of consecutive
+// characters of the same class, sorted by their first character
+var classTable = []classRun{
+	{0x00c0, 0x00d6, classLetters | 0x0000},
+	{0x00d8, 0x00de, classLetters | 0x0000},
+	{0x00df, 0x00f6, classLetters | 0x0001},
+	{0x00f8, 0x00ff, classLetters | 0x0001},
+	{0x0100, 0x0100, classLetters | 0x0000},
+	{0x0101, 0x0101, classLetters | 0x0001},
+	{0x0102, 0x0102, classLetters | 0x0000},
+	{0x0103, 0x0103, classLetters | 0x0001},
+	{0x0104, 0x0104, classLetters | 0x0000},
+	{0x0105, 0x0105, classLetters | 0x0001},
+	{0x0106, 0x0106, classLetters | 0x0000},
+	{0x0107, 0x0107, classLetters | 0x0001},
+	{0x0108, 0x0108, classLetters | 0x0000},
+	{0x0109, 0x0109, classLetters | 0x0001},
+	{0x010a, 0x010a, classLetters | 0x0000},
+	{0x010b, 0x010b, classLetters | 0x0001},
+	{0x010c, 0x010c, classLetters | 0x0000},
+	{0x010d, 0x010d, classLetters | 0x0001},
+	{0x010e, 0x010e, classLetters | 0x0000},
+	{0x010f, 0x010f, classLetters | 0x0001},
+	{0x0110, 0x0110, classLetters | 0x0000},
+	{0x0111, 0x0111, classLetters | 0x0001},
+	{0x0112, 0x0112, classLetters | 0x0000},
+	{0x0113, 0x0113, classLetters | 0x0001},
+	{0x0114, 0x0114, classLetters | 0x0000},
+	{0x0115, 0x0115, classLetters | 0x0001},
+	{0x0116, 0x0116, classLetters | 0x0000},
+	{0x0117, 0x0117, classLetters | 0x0001},
+	{0x0118, 0x0118, classLetters | 0x0000},
+	{0x0119, 0x0119, classLetters | 0x0001},
+	{0x011a, 0x011a, classLetters | 0x0000},
+	{0x011b, 0x011b, classLetters | 0x0001},
+	{0x011c, 0x011c, classLetters | 0x0000},
+	{0x011d, 0x011d, classLetters | 0x0001},
+	{0x011e, 0x011e, classLetters | 0x0000},
+	{0x011f, 0x011f, classLetters | 0x0001},
+	{0x0120, 0x0120, classLetters | 0x0000},
+	{0x0121, 0x0121, classLetters | 0x0001},
+	{0x0122, 0x0122, classLetters | 0x0000},
+	{0x0123, 0x0123, classLetters | 0x0001},
+	{0x0124, 0x0124, classLetters | 0x0000},
+	{0x0125, 0x0125, classLetters | 0x0001},
+	{0x0126, 0x0126, classLetters | 0x0000},
+	{0x0127, 0x0127, classLetters | 0x0001},
+	{0x0128, 0x0128, classLetters | 0x0000},
+	{0x0129, 0x0129, classLetters | 0x0001},
+	{0x012a, 0x012a, classLetters | 0x0000},
+	{0x012b, 0x012b, classLetters | 0x0001},
+	{0x012c, 0x012c, classLetters | 0x0000},
+	{0x012d, 0x012d, classLetters | 0x0001},
+	{0x012e, 0x012e, classLetters | 0x0000},
+	{0x012f, 0x012f, classLetters | 0x0001},
+	{0x0130, 0x0130, classLetters | 0x0000},
+	{0x0131, 0x0131, classLetters | 0x0001},
+	{0x0132, 0x0132, classLetters | 0x0000},
+	{0x0133, 0x0133, classLetters | 0x0001},
+	{0x0134, 0x0134, classLetters | 0x0000},
+	{0x0135, 0x0135, classLetters | 0x0001},
+	{0x0136, 0x0136, classLetters | 0x0000},
+	{0x0137, 0x0138, classLetters | 0x0001},
+	{0x0139, 0x0139, classLetters | 0x0000},
+	{0x013a, 0x013a, classLetters | 0x0001},
+	{0x013b, 0x013b, classLetters | 0x0000},
+	{0x013c, 0x013c, classLetters | 0x0001},
+	{0x013d, 0x013d, classLetters | 0x0000},
+	{0x013e, 0x013e, classLetters | 0x0001},
+	{0x013f, 0x013f, classLetters | 0x0000},
+	{0x0140, 0x0140, classLetters | 0x0001},
+	{0x0141, 0x0141, classLetters | 0x0000},
+	{0x0142, 0x0142, classLetters | 0x0001},
+	{0x0143, 0x0143, classLetters | 0x0000},
+	{0x0144, 0x0144, classLetters | 0x0001},
+	{0x0145, 0x0145, classLetters | 0x0000},
+	{0x0146, 0x0146, classLetters | 0x0001},
+	{0x0147, 0x0147, classLetters | 0x0000},
+	{0x0148, 0x0149, classLetters | 0x0001},
+	{0x014a, 0x014a, classLetters | 0x0000},
+	{0x014b, 0x014b, classLetters | 0x0001},
+	{0x014c, 0x014c, classLetters | 0x0000},
+	{0x014d, 0x014d, classLetters | 0x0001},
+	{0x014e, 0x014e, classLetters | 0x0000},
+	{0x014f, 0x014f, classLetters | 0x0001},
+	{0x0150, 0x0150, classLetters | 0x0000},
+	{0x0151, 0x0151, classLetters | 0x0001},
+	{0x0152, 0x0152, classLetters | 0x0000},
+	{0x0153, 0x0153, classLetters | 0x0001},
+	{0x0154, 0x0154, classLetters | 0x0000},
+	{0x0155, 0x0155, classLetters | 0x0001},
+	{0x0156, 0x0156, classLetters | 0x0000},
+	{0x0157, 0x0157, classLetters | 0x0001},
+	{0x0158, 0x0158, classLetters | 0x0000},
+	{0x0159, 0x0159, classLetters | 0x0001},
+	{0x015a, 0x015a, classLetters | 0x0000},
+	{0x015b, 0x015b, classLetters | 0x0001},
+	{0x015c, 0x015c, classLetters | 0x0000},
+	{0x015d, 0x015d, classLetters | 0x0001},
+	{0x015e, 0x015e, classLetters | 0x0000},
+	{0x015f, 0x015f, classLetters | 0x0001},
+	{0x0160, 0x0160, classLetters | 0x0000},
+	{0x0161, 0x0161, classLetters | 0x0001},
+	{0x0162, 0x0162, classLetters | 0x0000},
+	{0x0163, 0x0163, classLetters | 0x0001},
+	{0x0164, 0x0164, classLetters | 0x0000},
+	{0x0165, 0x0165, classLetters | 0x0001},
+	{0x0166, 0x0166, classLetters | 0x0000},
+	{0x0167, 0x0167, classLetters | 0x0001},
+	{0x0168, 0x0168, classLetters | 0x0000},
+	{0x0169, 0x0169, classLetters | 0x0001},
+	{0x016a, 0x016a, classLetters | 0x0000},
+	{0x016b, 0x016b, classLetters | 0x0001},
+	{0x016c, 0x016c, classLetters | 0x0000},
+	{0x016d, 0x016d, classLetters | 0x0001},
+	{0x016e, 0x016e, classLetters | 0x0000},
+	{0x016f, 0x016f, classLetters | 0x0001},
+	{0x0170, 0x0170, classLetters | 0x0000},
+	{0x0171, 0x0171, classLetters | 0x0001},
+	{0x0172, 0x0172, classLetters | 0x0000},
+	{0x0173, 0x0173, classLetters | 0x0001},
+	{0x0174, 0x0174, classLetters | 0x0000},
+	{0x0175, 0x0175, classLetters | 0x0001},
+	{0x0176, 0x0176, classLetters | 0x0000},
+	{0x0177, 0x0177, classLetters | 0x0001},
+	{0x0178, 0x0179, classLetters | 0x0000},
+	{0x017a, 0x017a, classLetters | 0x0001},
+	{0x017b, 0x017b, classLetters | 0x0000},
+	{0x017c, 0x017c, classLetters | 0x0001},
+	{0x017d, 0x017d, classLetters | 0x0000},
+	{0x017e, 0x0180, classLetters | 0x0001},
+	{0x0181, 0x0182, classLetters | 0x0000},
+	{0x0183, 0x0183, classLetters | 0x0001},
+	{0x0184, 0x0184, classLetters | 0x0000},
+	{0x0185, 0x0185, classLetters | 0x0001},
+	{0x0186, 0x0187, classLetters | 0x0000},
+	{0x0188, 0x0188, classLetters | 0x0001},
+	{0x0189, 0x018b, classLetters | 0x0000},
+	{0x018c, 0x018d, classLetters | 0x0001},
+	{0x018e, 0x0191, classLetters | 0x0000},
+	{0x0192, 0x0192, classLetters | 0x0001},
+	{0x0193, 0x0194, classLetters | 0x0000},
+	{0x0195, 0x0195, classLetters | 0x0001},
+	{0x0196, 0x0198, classLetters | 0x0000},
+	{0x0199, 0x019b, classLetters | 0x0001},
+	{0x019c, 0x019d, classLetters | 0x0000},
+	{0x019e, 0x019e, classLetters | 0x0001},
+	{0x019f, 0x01a0, classLetters | 0x0000},
+	{0x01a1, 0x01a1, classLetters | 0x0001},
+	{0x01a2, 0x01a2, classLetters | 0x0000},
+	{0x01a3, 0x01a3, classLetters | 0x0001},
+	{0x01a4, 0x01a4, classLetters | 0x0000},
+	{0x01a5, 0x01a5, classLetters | 0x0001},
+	{0x01a6, 0x01a7, classLetters | 0x0000},
+	{0x01a8, 0x01a8, classLetters | 0x0001},
+	{0x01a9, 0x01a9, classLetters | 0x0000},
+	{0x01aa, 0x01ab, classLetters | 0x0001},
+	{0x01ac, 0x01ac, classLetters | 0x0000},
+	{0x01ad, 0x01ad, classLetters | 0x0001},
+	{0x01ae, 0x01af, classLetters | 0x0000},
+	{0x01b0, 0x01b0, classLetters | 0x0001},
+	{0x01b1, 0x01b3, classLetters | 0x0000},
+	{0x01b4, 0x01b4, classLetters | 0x0001},
+	{0x01b5, 0x01b5, classLetters | 0x0000},
+	{0x01b6, 0x01b6, classLetters | 0x0001},
+	{0x01b7, 0x01b8, classLetters | 0x0000},
+	{0x01b9, 0x01ba, classLetters | 0x0001},
+	{0x01bc, 0x01bc, classLetters | 0x0000},
+	{0x01bd, 0x01bf, classLetters | 0x0001},
+	{0x01c4, 0x01c4, classLetters | 0x0000},
+	{0x01c6, 0x01c6, classLetters | 0x0001},
+	{0x01c7, 0x01c7, classLetters | 0x0000},
+	{0x01c9, 0x01c9, classLetters | 0x0001},
+	{0x01ca, 0x01ca, classLetters | 0x0000},
+	{0x01cc, 0x01cc, classLetters | 0x0001},
+	{0x01cd, 0x01cd, classLetters | 0x0000},
+	{0x01ce, 0x01ce, classLetters | 0x0001},
+	{0x01cf, 0x01cf, classLetters | 0x0000},
+	{0x01d0, 0x01d0, classLetters | 0x0001},
+	{0x01d1, 0x01d1, classLetters | 0x0000},
+	{0x01d2, 0x01d2, classLetters | 0x0001},
+	{0x01d3, 0x01d3, classLetters | 0x0000},
+	{0x01d4, 0x01d4, classLetters | 0x0001},
+	{0x01d5, 0x01d5, classLetters | 0x0000},
+	{0x01d6, 0x01d6, classLetters | 0x0001},
+	{0x01d7, 0x01d7, classLetters | 0x0000},
+	{0x01d8, 0x01d8, classLetters | 0x0001},
+	{0x01d9, 0x01d9, classLetters | 0x0000},
+	{0x01da, 0x01da, classLetters | 0x0001},
+	{0x01db, 0x01db, classLetters | 0x0000},
+	{0x01dc, 0x01dd, classLetters | 0x0001},
+	{0x01de, 0x01de, classLetters | 0x0000},
+	{0x01df, 0x01df, classLetters | 0x0001},
+	{0x01e0, 0x01e0, classLetters | 0x0000},
+	{0x01e1, 0x01e1, classLetters | 0x0001},
+	{0x01e2, 0x01e2, classLetters | 0x0000},
+	{0x01e3, 0x01e3, classLetters | 0x0001},
+	{0x01e4, 0x01e4, classLetters | 0x0000},
+	{0x01e5, 0x01e5, classLetters | 0x0001},
+	{0x01e6, 0x01e6, classLetters | 0x0000},
+	{0x01e7, 0x01e7, classLetters | 0x0001},
+	{0x01e8, 0x01e8, classLetters | 0x0000},
+	{0x01e9, 0x01e9, classLetters | 0x0001},
+	{0x01ea, 0x01ea, classLetters | 0x0000},
+	{0x01eb, 0x01eb, classLetters | 0x0001},
+	{0x01ec, 0x01ec, classLetters | 0x0000},
+	{0x01ed, 0x01ed, classLetters | 0x0001},
+	{0x01ee, 0x01ee, classLetters | 0x0000},
+	{0x01ef, 0x01f0, classLetters | 0x0001},
+	{0x01f1, 0x01f1, classLetters | 0x0000},
+	{0x01f3, 0x01f3, classLetters | 0x0001},
+	{0x01f4, 0x01f4, classLetters | 0x0000},
+	{0x01f5, 0x01f5, classLetters | 0x0001},
+	{0x01f6, 0x01f8, classLetters | 0x0000},
+	{0x01f9, 0x01f9, classLetters | 0x0001},
+	{0x01fa, 0x01fa, classLetters | 0x0000},
+	{0x01fb, 0x01fb, classLetters | 0x0001},
+	{0x01fc, 0x01fc, classLetters | 0x0000},
+	{0x01fd, 0x01fd, classLetters | 0x0001},
+	{0x01fe, 0x01fe, classLetters | 0x0000},
+	{0x01ff, 0x01ff, classLetters | 0x0001},
+	{0x0200, 0x0200, classLetters | 0x0000},
+	{0x0201, 0x0201, classLetters | 0x0001},
+	{0x0202, 0x0202, classLetters | 0x0000},
+	{0x0203, 0x0203, classLetters | 0x0001},
+	{0x0204, 0x0204, classLetters | 0x0000},
+	{0x0205, 0x0205, classLetters | 0x0001},
+	{0x0206, 0x0206, classLetters | 0x0000},
+	{0x0207, 0x0207, classLetters | 0x0001},
+	{0x0208, 0x0208, classLetters | 0x0000},
+	{0x0209, 0x0209, classLetters | 0x0001},
+	{0x020a, 0x020a, classLetters | 0x0000},
+	{0x020b, 0x020b, classLetters | 0x0001},
+	{0x020c, 0x020c, classLetters | 0x0000},
+	{0x020d, 0x020d, classLetters | 0x0001},
+	{0x020e, 0x020e, classLetters | 0x0000},
+	{0x020f, 0x020f, classLetters | 0x0001},
+	{0x0210, 0x0210, classLetters | 0x0000},
+	{0x0211, 0x0211, classLetters | 0x0001},
+	{0x0212, 0x0212, classLetters | 0x0000},
+	{0x0213, 0x0213, classLetters | 0x0001},
+	{0x0214, 0x0214, classLetters | 0x0000},
+	{0x0215, 0x0215, classLetters | 0x0001},
+	{0x0216, 0x0216, classLetters | 0x0000},
+	{0x0217, 0x0217, classLetters | 0x0001},
+	{0x0218, 0x0218, classLetters | 0x0000},
+	{0x0219, 0x0219, classLetters | 0x0001},
+	{0x021a, 0x021a, classLetters | 0x0000},
+	{0x021b, 0x021b, classLetters | 0x0001},
+	{0x021c, 0x021c, classLetters | 0x0000},
+	{0x021d, 0x021d, classLetters | 0x0001},
+	{0x021e, 0x021e, classLetters | 0x0000},
+	{0x021f, 0x021f, classLetters | 0x0001},
+	{0x0220, 0x0220, classLetters | 0x0000},
+	{0x0221, 0x0221, classLetters | 0x0001},
+	{0x0222, 0x0222, classLetters | 0x0000},
+	{0x0223, 0x0223, classLetters | 0x0001},
+	{0x0224, 0x0224, classLetters | 0x0000},
+	{0x0225, 0x0225, classLetters | 0x0001},
+	{0x0226, 0x0226, classLetters | 0x0000},
+	{0x0227, 0x0227, classLetters | 0x0001},
+	{0x0228, 0x0228, classLetters | 0x0000},
+	{0x0229, 0x0229, classLetters | 0x0001},
+	{0x022a, 0x022a, classLetters | 0x0000},
+	{0x022b, 0x022b, classLetters | 0x0001},
+	{0x022c, 0x022c, classLetters | 0x0000},
+	{0x022d, 0x022d, classLetters | 0x0001},
+	{0x022e, 0x022e, classLetters | 0x0000},
+	{0x022f, 0x022f, classLetters | 0x0001},
+	{0x0230, 0x0230, classLetters | 0x0000},
+	{0x0231, 0x0231, classLetters | 0x0001},
+	{0x0232, 0x0232, classLetters | 0x0000},
+	{0x0233, 0x0239, classLetters | 0x0001},
+	{0x023a, 0x023b, classLetters | 0x0000},
+	{0x023c, 0x023c, classLetters | 0x0001},
+	{0x023d, 0x023e, classLetters | 0x0000},
+	{0x023f, 0x0240, classLetters | 0x0001},
+	{0x0241, 0x0241, classLetters | 0x0000},
+	{0x0242, 0x0242, classLetters | 0x0001},
+	{0x0243, 0x0246, classLetters | 0x0000},
+	{0x0247, 0x0247, classLetters | 0x0001},
+	{0x0248, 0x0248, classLetters | 0x0000},
+	{0x0249, 0x0249, classLetters | 0x0001},
+	{0x024a, 0x024a, classLetters | 0x0000},
+	{0x024b, 0x024b, classLetters | 0x0001},
+	{0x024c, 0x024c, classLetters | 0x0000},
+	{0x024d, 0x024d, classLetters | 0x0001},
+	{0x024e, 0x024e, classLetters | 0x0000},
+	{0x024f, 0x0293, classLetters | 0x0001},
+	{0x0296, 0x02af, classLetters | 0x0001},
+	{0x0370, 0x0370, classLetters | 0x0002},
+	{0x0371, 0x0371, classLetters | 0x0003},
+	{0x0372, 0x0372, classLetters | 0x0002},
+	{0x0373, 0x0373, classLetters | 0x0003},
+	{0x0376, 0x0376, classLetters | 0x0002},
+	{0x0377, 0x0377, classLetters | 0x0003},
+	{0x037b, 0x037d, classLetters | 0x0003},
+	{0x037f, 0x037f, classLetters | 0x0002},
+	{0x0386, 0x0386, classLetters | 0x0002},
+	{0x0388, 0x038a, classLetters | 0x0002},
+	{0x038c, 0x038c, classLetters | 0x0002},
+	{0x038e, 0x038f, classLetters | 0x0002},
+	{0x0390, 0x0390, classLetters | 0x0003},
+	{0x0391, 0x03a1, classLetters | 0x0002},
+	{0x03a3, 0x03ab, classLetters | 0x0002},
+	{0x03ac, 0x03ce, classLetters | 0x0003},
+	{0x03cf, 0x03cf, classLetters | 0x0002},
+	{0x03d0, 0x03d1, classLetters | 0x0003},
+	{0x03d2, 0x03d4, classLetters | 0x0002},
+	{0x03d5, 0x03d7, classLetters | 0x0003},
+	{0x03d8, 0x03d8, classLetters | 0x0002},
+	{0x03d9, 0x03d9, classLetters | 0x0003},
+	{0x03da, 0x03da, classLetters | 0x0002},
+	{0x03db, 0x03db, classLetters | 0x0003},
+	{0x03dc, 0x03dc, classLetters | 0x0002},
+	{0x03dd, 0x03dd, classLetters | 0x0003},
+	{0x03de, 0x03de, classLetters | 0x0002},
+	{0x03df, 0x03df, classLetters | 0x0003},
+	{0x03e0, 0x03e0, classLetters | 0x0002},
+	{0x03e1, 0x03e1, classLetters | 0x0003},
+	{0x03e2, 0x03e2, classLetters | 0x000a},
+	{0x03e3, 0x03e3, classLetters | 0x000b},
+	{0x03e4, 0x03e4, classLetters | 0x000a},
+	{0x03e5, 0x03e5, classLetters | 0x000b},
+	{0x03e6, 0x03e6, classLetters | 0x000a},
+	{0x03e7, 0x03e7, classLetters | 0x000b},
+	{0x03e8, 0x03e8, classLetters | 0x000a},
+	{0x03e9, 0x03e9, classLetters | 0x000b},
+	{0x03ea, 0x03ea, classLetters | 0x000a},
+	{0x03eb, 0x03eb, classLetters | 0x000b},
+	{0x03ec, 0x03ec, classLetters | 0x000a},
+	{0x03ed, 0x03ed, classLetters | 0x000b},
+	{0x03ee, 0x03ee, classLetters | 0x000a},
+	{0x03ef, 0x03ef, classLetters | 0x000b},
+	{0x03f0, 0x03f3, classLetters | 0x0003},
+	{0x03f4, 0x03f4, classLetters | 0x0002},
+	{0x03f5, 0x03f5, classLetters | 0x0003},
+	{0x03f7, 0x03f7, classLetters | 0x0002},
+	{0x03f8, 0x03f8, classLetters | 0x0003},
+	{0x03f9, 0x03fa, classLetters | 0x0002},
+	{0x03fb, 0x03fc, classLetters | 0x0003},
+	{0x03fd, 0x03ff, classLetters | 0x0002},
+	{0x0400, 0x042f, classLetters | 0x0004},
+	{0x0430, 0x045f, classLetters | 0x0005},
+	{0x0460, 0x0460, classLetters | 0x0004},
+	{0x0461, 0x0461, classLetters | 0x0005},
+	{0x0462, 0x0462, classLetters | 0x0004},
+	{0x0463, 0x0463, classLetters | 0x0005},
+	{0x0464, 0x0464, classLetters | 0x0004},
+	{0x0465, 0x0465, classLetters | 0x0005},
+	{0x0466, 0x0466, classLetters | 0x0004},
+	{0x0467, 0x0467, classLetters | 0x0005},
+	{0x0468, 0x0468, classLetters | 0x0004},
+	{0x0469, 0x0469, classLetters | 0x0005},
+	{0x046a, 0x046a, classLetters | 0x0004},
+	{0x046b, 0x046b, classLetters | 0x0005},
+	{0x046c, 0x046c, classLetters | 0x0004},
+	{0x046d, 0x046d, classLetters | 0x0005},
+	{0x046e, 0x046e, classLetters | 0x0004},
+	{0x046f, 0x046f, classLetters | 0x0005},
+	{0x0470, 0x0470, classLetters | 0x0004},
+	{0x0471, 0x0471, classLetters | 0x0005},
+	{0x0472, 0x0472, classLetters | 0x0004},
+	{0x0473, 0x0473, classLetters | 0x0005},
+	{0x0474, 0x0474, classLetters | 0x0004},
+	{0x0475, 0x0475, classLetters | 0x0005},
+	{0x0476, 0x0476, classLetters | 0x0004},
+	{0x0477, 0x0477, classLetters | 0x0005},
+	{0x0478, 0x0478, classLetters | 0x0004},
+	{0x0479, 0x0479, classLetters | 0x0005},
+	{0x047a, 0x047a, classLetters | 0x0004},
+	{0x047b, 0x047b, classLetters | 0x0005},
+	{0x047c, 0x047c, classLetters | 0x0004},
+	{0x047d, 0x047d, classLetters | 0x0005},
+	{0x047e, 0x047e, classLetters | 0x0004},
+	{0x047f, 0x047f, classLetters | 0x0005},
+	{0x0480, 0x0480, classLetters | 0x0004},
+	{0x0481, 0x0481, classLetters | 0x0005},
+	{0x048a, 0x048a, classLetters | 0x0004},
+	{0x048b, 0x048b, classLetters | 0x0005},
+	{0x048c, 0x048c, classLetters | 0x0004},
+	{0x048d, 0x048d, classLetters | 0x0005},
+	{0x048e, 0x048e, classLetters | 0x0004},
+	{0x048f, 0x048f, classLetters | 0x0005},
+	{0x0490, 0x0490, classLetters | 0x0004},
+	{0x0491, 0x0491, classLetters | 0x0005},
+	{0x0492, 0x0492, classLetters | 0x0004},
+	{0x0493, 0x0493, classLetters | 0x0005},
+	{0x0494, 0x0494, classLetters | 0x0004},
+	{0x0495, 0x0495, classLetters | 0x0005},
+	{0x0496, 0x0496, classLetters | 0x0004},
+	{0x0497, 0x0497, classLetters | 0x0005},
+	{0x0498, 0x0498, classLetters | 0x0004},
+	{0x0499, 0x0499, classLetters | 0x0005},
+	{0x049a, 0x049a, classLetters | 0x0004},
+	{0x049b, 0x049b, classLetters | 0x0005},
+	{0x049c, 0x049c, classLetters | 0x0004},
+	{0x049d, 0x049d, classLetters | 0x0005},
+	{0x049e, 0x049e, classLetters | 0x0004},
+	{0x049f, 0x049f, classLetters | 0x0005},
+	{0x04a0, 0x04a0, classLetters | 0x0004},
+	{0x04a1, 0x04a1, classLetters | 0x0005},
+	{0x04a2, 0x04a2, classLetters | 0x0004},
+	{0x04a3, 0x04a3, classLetters | 0x0005},
+	{0x04a4, 0x04a4, classLetters | 0x0004},
+	{0x04a5, 0x04a5, classLetters | 0x0005},
+	{0x04a6, 0x04a6, classLetters | 0x0004},
+	{0x04a7, 0x04a7, classLetters | 0x0005},
+	{0x04a8, 0x04a8, classLetters | 0x0004},
+	{0x04a9, 0x04a9, classLetters | 0x0005},
+	{0x04aa, 0x04aa, classLetters | 0x0004},
+	{0x04ab, 0x04ab, classLetters | 0x0005},
+	{0x04ac, 0x04ac, classLetters | 0x0004},
+	{0x04ad, 0x04ad, classLetters | 0x0005},
+	{0x04ae, 0x04ae, classLetters | 0x0004},
+	{0x04af, 0x04af, classLetters | 0x0005},
+	{0x04b0, 0x04b0, classLetters | 0x0004},
+	{0x04b1, 0x04b1, classLetters | 0x0005},
+	{0x04b2, 0x04b2, classLetters | 0x0004},
+	{0x04b3, 0x04b3, classLetters | 0x0005},
+	{0x04b4, 0x04b4, classLetters | 0x0004},
+	{0x04b5, 0x04b5, classLetters | 0x0005},
+	{0x04b6, 0x04b6, classLetters | 0x0004},
+	{0x04b7, 0x04b7, classLetters | 0x0005},
+	{0x04b8, 0x04b8, classLetters | 0x0004},
+	{0x04b9, 0x04b9, classLetters | 0x0005},
+	{0x04ba, 0x04ba, classLetters | 0x0004},
+	{0x04bb, 0x04bb, classLetters | 0x0005},
+	{0x04bc, 0x04bc, classLetters | 0x0004},
+	{0x04bd, 0x04bd, classLetters | 0x0005},
+	{0x04be, 0x04be, classLetters | 0x0004},
+	{0x04bf, 0x04bf, classLetters | 0x0005},
+	{0x04c0, 0x04c1, classLetters | 0x0004},
+	{0x04c2, 0x04c2, classLetters | 0x0005},
+	{0x04c3, 0x04c3, classLetters | 0x0004},
+	{0x04c4, 0x04c4, classLetters | 0x0005},
+	{0x04c5, 0x04c5, classLetters | 0x0004},
+	{0x04c6, 0x04c6, classLetters | 0x0005},
+	{0x04c7, 0x04c7, classLetters | 0x0004},
+	{0x04c8, 0x04c8, classLetters | 0x0005},
+	{0x04c9, 0x04c9, classLetters | 0x0004},
+	{0x04ca, 0x04ca, classLetters | 0x0005},
+	{0x04cb, 0x04cb, classLetters | 0x0004},
+	{0x04cc, 0x04cc, classLetters | 0x0005},
+	{0x04cd, 0x04cd, classLetters | 0x0004},
+	{0x04ce, 0x04cf, classLetters | 0x0005},
+	{0x04d0, 0x04d0, classLetters | 0x0004},
+	{0x04d1, 0x04d1, classLetters | 0x0005},
+	{0x04d2, 0x04d2, classLetters | 0x0004},
+	{0x04d3, 0x04d3, classLetters | 0x0005},
+	{0x04d4, 0x04d4, classLetters | 0x0004},
+	{0x04d5, 0x04d5, classLetters | 0x0005},
+	{0x04d6, 0x04d6, classLetters | 0x0004},
+	{0x04d7, 0x04d7, classLetters | 0x0005},
+	{0x04d8, 0x04d8, classLetters | 0x0004},
+	{0x04d9, 0x04d9, classLetters | 0x0005},
+	{0x04da, 0x04da, classLetters | 0x0004},
+	{0x04db, 0x04db, classLetters | 0x0005},
+	{0x04dc, 0x04dc, classLetters | 0x0004},
+	{0x04dd, 0x04dd, classLetters | 0x0005},
+	{0x04de, 0x04de, classLetters | 0x0004},
+	{0x04df, 0x04df, classLetters | 0x0005},
+	{0x04e0, 0x04e0, classLetters | 0x0004},
+	{0x04e1, 0x04e1, classLetters | 0x0005},
+	{0x04e2, 0x04e2, classLetters | 0x0004},
+	{0x04e3, 0x04e3, classLetters | 0x0005},
+	{0x04e4, 0x04e4, classLetters | 0x0004},
+	{0x04e5, 0x04e5, classLetters | 0x0005},
+	{0x04e6, 0x04e6, classLetters | 0x0004},
+	{0x04e7, 0x04e7, classLetters | 0x0005},
+	{0x04e8, 0x04e8, classLetters | 0x0004},
+	{0x04e9, 0x04e9, classLetters | 0x0005},
+	{0x04ea, 0x04ea, classLetters | 0x0004},
+	{0x04eb, 0x04eb, classLetters | 0x0005},
+	{0x04ec, 0x04ec, classLetters | 0x0004},
+	{0x04ed, 0x04ed, classLetters | 0x0005},
+	{0x04ee, 0x04ee, classLetters | 0x0004},
+	{0x04ef, 0x04ef, classLetters | 0x0005},
+	{0x04f0, 0x04f0, classLetters | 0x0004},
+	{0x04f1, 0x04f1, classLetters | 0x0005},
+	{0x04f2, 0x04f2, classLetters | 0x0004},
+	{0x04f3, 0x04f3, classLetters | 0x0005},
+	{0x04f4, 0x04f4, classLetters | 0x0004},
+	{0x04f5, 0x04f5, classLetters | 0x0005},
+	{0x04f6, 0x04f6, classLetters | 0x0004},
+	{0x04f7, 0x04f7, classLetters | 0x0005},
+	{0x04f8, 0x04f8, classLetters | 0x0004},
+	{0x04f9, 0x04f9, classLetters | 0x0005},
+	{0x04fa, 0x04fa, classLetters | 0x0004},
+	{0x04fb, 0x04fb, classLetters | 0x0005},
+	{0x04fc, 0x04fc, classLetters | 0x0004},
+	{0x04fd, 0x04fd, classLetters | 0x0005},
+	{0x04fe, 0x04fe, classLetters | 0x0004},
+	{0x04ff, 0x04ff, classLetters | 0x0005},
+	{0x0500, 0x0500, classLetters | 0x0004},
+	{0x0501, 0x0501, classLetters | 0x0005},
+	{0x0502, 0x0502, classLetters | 0x0004},
+	{0x0503, 0x0503, classLetters | 0x0005},
+	{0x0504, 0x0504, classLetters | 0x0004},
+	{0x0505, 0x0505, classLetters | 0x0005},
+	{0x0506, 0x0506, classLetters | 0x0004},
+	{0x0507, 0x0507, classLetters | 0x0005},
+	{0x0508, 0x0508, classLetters | 0x0004},
+	{0x0509, 0x0509, classLetters | 0x0005},
+	{0x050a, 0x050a, classLetters | 0x0004},
+	{0x050b, 0x050b, classLetters | 0x0005},
+	{0x050c, 0x050c, classLetters | 0x0004},
+	{0x050d, 0x050d, classLetters | 0x0005},
+	{0x050e, 0x050e, classLetters | 0x0004},
+	{0x050f, 0x050f, classLetters | 0x0005},
+	{0x0510, 0x0510, classLetters | 0x0004},
+	{0x0511, 0x0511, classLetters | 0x0005},
+	{0x0512, 0x0512, classLetters | 0x0004},
+	{0x0513, 0x0513, classLetters | 0x0005},
+	{0x0514, 0x0514, classLetters | 0x0004},
+	{0x0515, 0x0515, classLetters | 0x0005},
+	{0x0516, 0x0516, classLetters | 0x0004},
+	{0x0517, 0x0517, classLetters | 0x0005},
+	{0x0518, 0x0518, classLetters | 0x0004},
+	{0x0519, 0x0519, classLetters | 0x0005},
+	{0x051a, 0x051a, classLetters | 0x0004},
+	{0x051b, 0x051b, classLetters | 0x0005},
+	{0x051c, 0x051c, classLetters | 0x0004},
+	{0x051d, 0x051d, classLetters | 0x0005},
+	{0x051e, 0x051e, classLetters | 0x0004},
+	{0x051f, 0x051f, classLetters | 0x0005},
+	{0x0520, 0x0520, classLetters | 0x0004},
+	{0x0521, 0x0521, classLetters | 0x0005},
+	{0x0522, 0x0522, classLetters | 0x0004},
+	{0x0523, 0x0523, classLetters | 0x0005},
+	{0x0524, 0x0524, classLetters | 0x0004},
+	{0x0525, 0x0525, classLetters | 0x0005},
+	{0x0526, 0x0526, classLetters | 0x0004},
+	{0x0527, 0x0527, classLetters | 0x0005},
+	{0x0528, 0x0528, classLetters | 0x0004},
+	{0x0529, 0x0529, classLetters | 0x0005},
+	{0x052a, 0x052a, classLetters | 0x0004},
+	{0x052b, 0x052b, classLetters | 0x0005},
+	{0x052c, 0x052c, classLetters | 0x0004},
+	{0x052d, 0x052d, classLetters | 0x0005},
+	{0x052e, 0x052e, classLetters | 0x0004},
+	{0x052f, 0x052f, classLetters | 0x0005},
+	{0x0531, 0x0556, classLetters | 0x0006},
+	{0x0560, 0x0588, classLetters | 0x0007},
+	{0x0660, 0x0669, classDigits | 0x0660},
+	{0x06f0, 0x06f9, classDigits | 0x06f0},
+	{0x07c0, 0x07c9, classDigits | 0x07c0},
+	{0x0966, 0x096f, classDigits | 0x0966},
+	{0x09e6, 0x09ef, classDigits | 0x09e6},
+	{0x0a66, 0x0a6f, classDigits | 0x0a66},
+	{0x0ae6, 0x0aef, classDigits | 0x0ae6},
+	{0x0b66, 0x0b6f, classDigits | 0x0b66},
+	{0x0be6, 0x0bef, classDigits | 0x0be6},
+	{0x0c66, 0x0c6f, classDigits | 0x0c66},
+	{0x0ce6, 0x0cef, classDigits | 0x0ce6},
+	{0x0d66, 0x0d6f, classDigits | 0x0d66},
+	{0x0de6, 0x0def, classDigits | 0x0de6},
+	{0x0e50, 0x0e59, classDigits | 0x0e50},
+	{0x0ed0, 0x0ed9, classDigits | 0x0ed0},
+	{0x0f20, 0x0f29, classDigits | 0x0f20},
+	{0x1040, 0x1049, classDigits | 0x1040},
+	{0x1090, 0x1099, classDigits | 0x1090},
+	{0x10a0, 0x10c5, classLetters | 0x0008},
+	{0x10c7, 0x10c7, classLetters | 0x0008},
+	{0x10cd, 0x10cd, classLetters | 0x0008},
+	{0x10d0, 0x10fa, classLetters | 0x0009},
+	{0x10fd, 0x10ff, classLetters | 0x0009},
+	{0x13a0, 0x13f5, classLetters | 0x000e},
+	{0x13f8, 0x13fd, classLetters | 0x000f},
+	{0x17e0, 0x17e9, classDigits | 0x17e0},
+	{0x1810, 0x1819, classDigits | 0x1810},
+	{0x1946, 0x194f, classDigits | 0x1946},
+	{0x19d0, 0x19d9, classDigits | 0x19d0},
+	{0x1a80, 0x1a89, classDigits | 0x1a80},
+	{0x1a90, 0x1a99, classDigits | 0x1a90},
+	{0x1b50, 0x1b59, classDigits | 0x1b50},
+	{0x1bb0, 0x1bb9, classDigits | 0x1bb0},
+	{0x1c40, 0x1c49, classDigits | 0x1c40},
+	{0x1c50, 0x1c59, classDigits | 0x1c50},
+	{0x1c80, 0x1c88, classLetters | 0x0005},
+	{0x1c90, 0x1cba, classLetters | 0x0008},
+	{0x1cbd, 0x1cbf, classLetters | 0x0008},
+	{0x1d00, 0x1d25, classLetters | 0x0001},
+	{0x1d26, 0x1d2a, classLetters | 0x0003},
+	{0x1d2b, 0x1d2b, classLetters | 0x0005},
+	{0x1d6b, 0x1d77, classLetters | 0x0001},
+	{0x1d79, 0x1d9a, classLetters | 0x0001},
+	{0x1e00, 0x1e00, classLetters | 0x0000},
+	{0x1e01, 0x1e01, classLetters | 0x0001},
+	{0x1e02, 0x1e02, classLetters | 0x0000},
+	{0x1e03, 0x1e03, classLetters | 0x0001},
+	{0x1e04, 0x1e04, classLetters | 0x0000},
+	{0x1e05, 0x1e05, classLetters | 0x0001},
+	{0x1e06, 0x1e06, classLetters | 0x0000},
+	{0x1e07, 0x1e07, classLetters | 0x0001},
+	{0x1e08, 0x1e08, classLetters | 0x0000},
+	{0x1e09, 0x1e09, classLetters | 0x0001},
+	{0x1e0a, 0x1e0a, classLetters | 0x0000},
+	{0x1e0b, 0x1e0b, classLetters | 0x0001},
+	{0x1e0c, 0x1e0c, classLetters | 0x0000},
+	{0x1e0d, 0x1e0d, classLetters | 0x0001},
+	{0x1e0e, 0x1e0e, classLetters | 0x0000},
+	{0x1e0f, 0x1e0f, classLetters | 0x0001},
+	{0x1e10, 0x1e10, classLetters | 0x0000},
+	{0x1e11, 0x1e11, classLetters | 0x0001},
+	{0x1e12, 0x1e12, classLetters | 0x0000},
+	{0x1e13, 0x1e13, classLetters | 0x0001},
+	{0x1e14, 0x1e14, classLetters | 0x0000},
+	{0x1e15, 0x1e15, classLetters | 0x0001},
+	{0x1e16, 0x1e16, classLetters | 0x0000},
+	{0x1e17, 0x1e17, classLetters | 0x0001},
+	{0x1e18, 0x1e18, classLetters | 0x0000},
+	{0x1e19, 0x1e19, classLetters | 0x0001},
+	{0x1e1a, 0x1e1a, classLetters | 0x0000},
+	{0x1e1b, 0x1e1b, classLetters | 0x0001},
+	{0x1e1c, 0x1e1c, classLetters | 0x0000},
+	{0x1e1d, 0x1e1d, classLetters | 0x0001},
+	{0x1e1e, 0x1e1e, classLetters | 0x0000},
+	{0x1e1f, 0x1e1f, classLetters | 0x0001},
+	{0x1e20, 0x1e20, classLetters | 0x0000},
+	{0x1e21, 0x1e21, classLetters | 0x0001},
+	{0x1e22, 0x1e22, classLetters | 0x0000},
+	{0x1e23, 0x1e23, classLetters | 0x0001},
+	{0x1e24, 0x1e24, classLetters | 0x0000},
+	{0x1e25, 0x1e25, classLetters | 0x0001},
+	{0x1e26, 0x1e26, classLetters | 0x0000},
+	{0x1e27, 0x1e27, classLetters | 0x0001},
+	{0x1e28, 0x1e28, classLetters | 0x0000},
+	{0x1e29, 0x1e29, classLetters | 0x0001},
+	{0x1e2a, 0x1e2a, classLetters | 0x0000},
+	{0x1e2b, 0x1e2b, classLetters | 0x0001},
+	{0x1e2c, 0x1e2c, classLetters | 0x0000},
+	{0x1e2d, 0x1e2d, classLetters | 0x0001},
+	{0x1e2e, 0x1e2e, classLetters | 0x0000},
+	{0x1e2f, 0x1e2f, classLetters | 0x0001},
+	{0x1e30, 0x1e30, classLetters | 0x0000},
+	{0x1e31, 0x1e31, classLetters | 0x0001},
+	{0x1e32, 0x1e32, classLetters | 0x0000},
+	{0x1e33, 0x1e33, classLetters | 0x0001},
+	{0x1e34, 0x1e34, classLetters | 0x0000},
+	{0x1e35, 0x1e35, classLetters | 0x0001},
+	{0x1e36, 0x1e36, classLetters | 0x0000},
+	{0x1e37, 0x1e37, classLetters | 0x0001},
+	{0x1e38, 0x1e38, classLetters | 0x0000},
+	{0x1e39, 0x1e39, classLetters | 0x0001},
+	{0x1e3a, 0x1e3a, classLetters | 0x0000},
+	{0x1e3b, 0x1e3b, classLetters | 0x0001},
+	{0x1e3c, 0x1e3c, classLetters | 0x0000},
+	{0x1e3d, 0x1e3d, classLetters | 0x0001},
+	{0x1e3e, 0x1e3e, classLetters | 0x0000},
+	{0x1e3f, 0x1e3f, classLetters | 0x0001},
+	{0x1e40, 0x1e40, classLetters | 0x0000},
+	{0x1e41, 0x1e41, classLetters | 0x0001},
+	{0x1e42, 0x1e42, classLetters | 0x0000},
+	{0x1e43, 0x1e43, classLetters | 0x0001},
+	{0x1e44, 0x1e44, classLetters | 0x0000},
+	{0x1e45, 0x1e45, classLetters | 0x0001},
+	{0x1e46, 0x1e46, classLetters | 0x0000},
+	{0x1e47, 0x1e47, classLetters | 0x0001},
+	{0x1e48, 0x1e48, classLetters | 0x0000},
+	{0x1e49, 0x1e49, classLetters | 0x0001},
+	{0x1e4a, 0x1e4a, classLetters | 0x0000},
+	{0x1e4b, 0x1e4b, classLetters | 0x0001},
+	{0x1e4c, 0x1e4c, classLetters | 0x0000},
+	{0x1e4d, 0x1e4d, classLetters | 0x0001},
+	{0x1e4e, 0x1e4e, classLetters | 0x0000},
+	{0x1e4f, 0x1e4f, classLetters | 0x0001},
+	{0x1e50, 0x1e50, classLetters | 0x0000},
+	{0x1e51, 0x1e51, classLetters | 0x0001},
+	{0x1e52, 0x1e52, classLetters | 0x0000},
+	{0x1e53, 0x1e53, classLetters | 0x0001},
+	{0x1e54, 0x1e54, classLetters | 0x0000},
+	{0x1e55, 0x1e55, classLetters | 0x0001},
+	{0x1e56, 0x1e56, classLetters | 0x0000},
+	{0x1e57, 0x1e57, classLetters | 0x0001},
+	{0x1e58, 0x1e58, classLetters | 0x0000},
+	{0x1e59, 0x1e59, classLetters | 0x0001},
+	{0x1e5a, 0x1e5a, classLetters | 0x0000},
+	{0x1e5b, 0x1e5b, classLetters | 0x0001},
+	{0x1e5c, 0x1e5c, classLetters | 0x0000},
+	{0x1e5d, 0x1e5d, classLetters | 0x0001},
+	{0x1e5e, 0x1e5e, classLetters | 0x0000},
+	{0x1e5f, 0x1e5f, classLetters | 0x0001},
+	{0x1e60, 0x1e60, classLetters | 0x0000},
+	{0x1e61, 0x1e61, classLetters | 0x0001},
+	{0x1e62, 0x1e62, classLetters | 0x0000},
+	{0x1e63, 0x1e63, classLetters | 0x0001},
+	{0x1e64, 0x1e64, classLetters | 0x0000},
+	{0x1e65, 0x1e65, classLetters | 0x0001},
+	{0x1e66, 0x1e66, classLetters | 0x0000},
+	{0x1e67, 0x1e67, classLetters | 0x0001},
+	{0x1e68, 0x1e68, classLetters | 0x0000},
+	{0x1e69, 0x1e69, classLetters | 0x0001},
+	{0x1e6a, 0x1e6a, classLetters | 0x0000},
+	{0x1e6b, 0x1e6b, classLetters | 0x0001},
+	{0x1e6c, 0x1e6c, classLetters | 0x0000},
+	{0x1e6d, 0x1e6d, classLetters | 0x0001},
+	{0x1e6e, 0x1e6e, classLetters | 0x0000},
+	{0x1e6f, 0x1e6f, classLetters | 0x0001},
+	{0x1e70, 0x1e70, classLetters | 0x0000},
+	{0x1e71, 0x1e71, classLetters | 0x0001},
+	{0x1e72, 0x1e72, classLetters | 0x0000},
+	{0x1e73, 0x1e73, classLetters | 0x0001},
+	{0x1e74, 0x1e74, classLetters | 0x0000},
+	{0x1e75, 0x1e75, classLetters | 0x0001},
+	{0x1e76, 0x1e76, classLetters | 0x0000},
+	{0x1e77, 0x1e77, classLetters | 0x0001},
+	{0x1e78, 0x1e78, classLetters | 0x0000},
+	{0x1e79, 0x1e79, classLetters | 0x0001},
+	{0x1e7a, 0x1e7a, classLetters | 0x0000},
+	{0x1e7b, 0x1e7b, classLetters | 0x0001},
+	{0x1e7c, 0x1e7c, classLetters | 0x0000},
+	{0x1e7d, 0x1e7d, classLetters | 0x0001},
+	{0x1e7e, 0x1e7e, classLetters | 0x0000},
+	{0x1e7f, 0x1e7f, classLetters | 0x0001},
+	{0x1e80, 0x1e80, classLetters | 0x0000},
+	{0x1e81, 0x1e81, classLetters | 0x0001},
+	{0x1e82, 0x1e82, classLetters | 0x0000},
+	{0x1e83, 0x1e83, classLetters | 0x0001},
+	{0x1e84, 0x1e84, classLetters | 0x0000},
+	{0x1e85, 0x1e85, classLetters | 0x0001},
+	{0x1e86, 0x1e86, classLetters | 0x0000},
+	{0x1e87, 0x1e87, classLetters | 0x0001},
+	{0x1e88, 0x1e88, classLetters | 0x0000},
+	{0x1e89, 0x1e89, classLetters | 0x0001},
+	{0x1e8a, 0x1e8a, classLetters | 0x0000},
+	{0x1e8b, 0x1e8b, classLetters | 0x0001},
+	{0x1e8c, 0x1e8c, classLetters | 0x0000},
+	{0x1e8d, 0x1e8d, classLetters | 0x0001},
+	{0x1e8e, 0x1e8e, classLetters | 0x0000},
+	{0x1e8f, 0x1e8f, classLetters | 0x0001},
+	{0x1e90, 0x1e90, classLetters | 0x0000},
+	{0x1e91, 0x1e91, classLetters | 0x0001},
+	{0x1e92, 0x1e92, classLetters | 0x0000},
+	{0x1e93, 0x1e93, classLetters | 0x0001},
+	{0x1e94, 0x1e94, classLetters | 0x0000},
+	{0x1e95, 0x1e9d, classLetters | 0x0001},
+	{0x1e9e, 0x1e9e, classLetters | 0x0000},
+	{0x1e9f, 0x1e9f, classLetters | 0x0001},
+	{0x1ea0, 0x1ea0, classLetters | 0x0000},
+	{0x1ea1, 0x1ea1, classLetters | 0x0001},
+	{0x1ea2, 0x1ea2, classLetters | 0x0000},
+	{0x1ea3, 0x1ea3, classLetters | 0x0001},
+	{0x1ea4, 0x1ea4, classLetters | 0x0000},
+	{0x1ea5, 0x1ea5, classLetters | 0x0001},
+	{0x1ea6, 0x1ea6, classLetters | 0x0000},
+	{0x1ea7, 0x1ea7, classLetters | 0x0001},
+	{0x1ea8, 0x1ea8, classLetters | 0x0000},
+	{0x1ea9, 0x1ea9, classLetters | 0x0001},
+	{0x1eaa, 0x1eaa, classLetters | 0x0000},
+	{0x1eab, 0x1eab, classLetters | 0x0001},
+	{0x1eac, 0x1eac, classLetters | 0x0000},
+	{0x1ead, 0x1ead, classLetters | 0x0001},
+	{0x1eae, 0x1eae, classLetters | 0x0000},
+	{0x1eaf, 0x1eaf, classLetters | 0x0001},
+	{0x1eb0, 0x1eb0, classLetters | 0x0000},
+	{0x1eb1, 0x1eb1, classLetters | 0x0001},
+	{0x1eb2, 0x1eb2, classLetters | 0x0000},
+	{0x1eb3, 0x1eb3, classLetters | 0x0001},
+	{0x1eb4, 0x1eb4, classLetters | 0x0000},
+	{0x1eb5, 0x1eb5, classLetters | 0x0001},
+	{0x1eb6, 0x1eb6, classLetters | 0x0000},
+	{0x1eb7, 0x1eb7, classLetters | 0x0001},
+	{0x1eb8, 0x1eb8, classLetters | 0x0000},
+	{0x1eb9, 0x1eb9, classLetters | 0x0001},
+	{0x1eba, 0x1eba, classLetters | 0x0000},
+	{0x1ebb, 0x1ebb, classLetters | 0x0001},
+	{0x1ebc, 0x1ebc, classLetters | 0x0000},
+	{0x1ebd, 0x1ebd, classLetters | 0x0001},
+	{0x1ebe, 0x1ebe, classLetters | 0x0000},
+	{0x1ebf, 0x1ebf, classLetters | 0x0001},
+	{0x1ec0, 0x1ec0, classLetters | 0x0000},
+	{0x1ec1, 0x1ec1, classLetters | 0x0001},
+	{0x1ec2, 0x1ec2, classLetters | 0x0000},
+	{0x1ec3, 0x1ec3, classLetters | 0x0001},
+	{0x1ec4, 0x1ec4, classLetters | 0x0000},
+	{0x1ec5, 0x1ec5, classLetters | 0x0001},
+	{0x1ec6, 0x1ec6, classLetters | 0x0000},
+	{0x1ec7, 0x1ec7, classLetters | 0x0001},
+	{0x1ec8, 0x1ec8, classLetters | 0x0000},
+	{0x1ec9, 0x1ec9, classLetters | 0x0001},
+	{0x1eca, 0x1eca, classLetters | 0x0000},
+	{0x1ecb, 0x1ecb, classLetters | 0x0001},
+	{0x1ecc, 0x1ecc, classLetters | 0x0000},
+	{0x1ecd, 0x1ecd, classLetters | 0x0001},
+	{0x1ece, 0x1ece, classLetters | 0x0000},
+	{0x1ecf, 0x1ecf, classLetters | 0x0001},
+	{0x1ed0, 0x1ed0, classLetters | 0x0000},
+	{0x1ed1, 0x1ed1, classLetters | 0x0001},
+	{0x1ed2, 0x1ed2, classLetters | 0x0000},
+	{0x1ed3, 0x1ed3, classLetters | 0x0001},
+	{0x1ed4, 0x1ed4, classLetters | 0x0000},
+	{0x1ed5, 0x1ed5, classLetters | 0x0001},
+	{0x1ed6, 0x1ed6, classLetters | 0x0000},
+	{0x1ed7, 0x1ed7, classLetters | 0x0001},
+	{0x1ed8, 0x1ed8, classLetters | 0x0000},
+	{0x1ed9, 0x1ed9, classLetters | 0x0001},
+	{0x1eda, 0x1eda, classLetters | 0x0000},
+	{0x1edb, 0x1edb, classLetters | 0x0001},
+	{0x1edc, 0x1edc, classLetters | 0x0000},
+	{0x1edd, 0x1edd, classLetters | 0x0001},
+	{0x1ede, 0x1ede, classLetters | 0x0000},
+	{0x1edf, 0x1edf, classLetters | 0x0001},
+	{0x1ee0, 0x1ee0, classLetters | 0x0000},
+	{0x1ee1, 0x1ee1, classLetters | 0x0001},
+	{0x1ee2, 0x1ee2, classLetters | 0x0000},
+	{0x1ee3, 0x1ee3, classLetters | 0x0001},
+	{0x1ee4, 0x1ee4, classLetters | 0x0000},
+	{0x1ee5, 0x1ee5, classLetters | 0x0001},
+	{0x1ee6, 0x1ee6, classLetters | 0x0000},
+	{0x1ee7, 0x1ee7, classLetters | 0x0001},
+	{0x1ee8, 0x1ee8, classLetters | 0x0000},
+	{0x1ee9, 0x1ee9, classLetters | 0x0001},
+	{0x1eea, 0x1eea, classLetters | 0x0000},
+	{0x1eeb, 0x1eeb, classLetters | 0x0001},
+	{0x1eec, 0x1eec, classLetters | 0x0000},
+	{0x1eed, 0x1eed, classLetters | 0x0001},
+	{0x1eee, 0x1eee, classLetters | 0x0000},
+	{0x1eef, 0x1eef, classLetters | 0x0001},
+	{0x1ef0, 0x1ef0, classLetters | 0x0000},
+	{0x1ef1, 0x1ef1, classLetters | 0x0001},
+	{0x1ef2, 0x1ef2, classLetters | 0x0000},
+	{0x1ef3, 0x1ef3, classLetters | 0x0001},
+	{0x1ef4, 0x1ef4, classLetters | 0x0000},
+	{0x1ef5, 0x1ef5, classLetters | 0x0001},
+	{0x1ef6, 0x1ef6, classLetters | 0x0000},
+	{0x1ef7, 0x1ef7, classLetters | 0x0001},
+	{0x1ef8, 0x1ef8, classLetters | 0x0000},
+	{0x1ef9, 0x1ef9, classLetters | 0x0001},
+	{0x1efa, 0x1efa, classLetters | 0x0000},
+	{0x1efb, 0x1efb, classLetters | 0x0001},
+	{0x1efc, 0x1efc, classLetters | 0x0000},
+	{0x1efd, 0x1efd, classLetters | 0x0001},
+	{0x1efe, 0x1efe, classLetters | 0x0000},
+	{0x1eff, 0x1eff, classLetters | 0x0001},
+	{0x1f00, 0x1f07, classLetters | 0x0003},
+	{0x1f08, 0x1f0f, classLetters | 0x0002},
+	{0x1f10, 0x1f15, classLetters | 0x0003},
+	{0x1f18, 0x1f1d, classLetters | 0x0002},
+	{0x1f20, 0x1f27, classLetters | 0x0003},
+	{0x1f28, 0x1f2f, classLetters | 0x0002},
+	{0x1f30, 0x1f37, classLetters | 0x0003},
+	{0x1f38, 0x1f3f, classLetters | 0x0002},
+	{0x1f40, 0x1f45, classLetters | 0x0003},
+	{0x1f48, 0x1f4d, classLetters | 0x0002},
+	{0x1f50, 0x1f57, classLetters | 0x0003},
+	{0x1f59, 0x1f59, classLetters | 0x0002},
+	{0x1f5b, 0x1f5b, classLetters | 0x0002},
+	{0x1f5d, 0x1f5d, classLetters | 0x0002},
+	{0x1f5f, 0x1f5f, classLetters | 0x0002},
+	{0x1f60, 0x1f67, classLetters | 0x0003},
+	{0x1f68, 0x1f6f, classLetters | 0x0002},
+	{0x1f70, 0x1f7d, classLetters | 0x0003},
+	{0x1f80, 0x1f87, classLetters | 0x0003},
+	{0x1f90, 0x1f97, classLetters | 0x0003},
+	{0x1fa0, 0x1fa7, classLetters | 0x0003},
+	{0x1fb0, 0x1fb4, classLetters | 0x0003},
+	{0x1fb6, 0x1fb7, classLetters | 0x0003},
+	{0x1fb8, 0x1fbb, classLetters | 0x0002},
+	{0x1fbe, 0x1fbe, classLetters | 0x0003},
+	{0x1fc2, 0x1fc4, classLetters | 0x0003},
+	{0x1fc6, 0x1fc7, classLetters | 0x0003},
+	{0x1fc8, 0x1fcb, classLetters | 0x0002},
+	{0x1fd0, 0x1fd3, classLetters | 0x0003},
+	{0x1fd6, 0x1fd7, classLetters | 0x0003},
+	{0x1fd8, 0x1fdb, classLetters | 0x0002},
+	{0x1fe0, 0x1fe7, classLetters | 0x0003},
+	{0x1fe8, 0x1fec, classLetters | 0x0002},
+	{0x1ff2, 0x1ff4, classLetters | 0x0003},
+	{0x1ff6, 0x1ff7, classLetters | 0x0003},
+	{0x1ff8, 0x1ffb, classLetters | 0x0002},
+	{0x2126, 0x2126, classLetters | 0x0002},
+	{0x212a, 0x212b, classLetters | 0x0000},
+	{0x2132, 0x2132, classLetters | 0x0000},
+	{0x214e, 0x214e, classLetters | 0x0001},
+	{0x2183, 0x2183, classLetters | 0x0000},
+	{0x2184, 0x2184, classLetters | 0x0001},
+	{0x2c00, 0x2c2e, classLetters | 0x000c},
+	{0x2c30, 0x2c5e, classLetters | 0x000d},
+	{0x2c60, 0x2c60, classLetters | 0x0000},
+	{0x2c61, 0x2c61, classLetters | 0x0001},
+	{0x2c62, 0x2c64, classLetters | 0x0000},
+	{0x2c65, 0x2c66, classLetters | 0x0001},
+	{0x2c67, 0x2c67, classLetters | 0x0000},
+	{0x2c68, 0x2c68, classLetters | 0x0001},
+	{0x2c69, 0x2c69, classLetters | 0x0000},
+	{0x2c6a, 0x2c6a, classLetters | 0x0001},
+	{0x2c6b, 0x2c6b, classLetters | 0x0000},
+	{0x2c6c, 0x2c6c, classLetters | 0x0001},
+	{0x2c6d, 0x2c70, classLetters | 0x0000},
+	{0x2c71, 0x2c71, classLetters | 0x0001},
+	{0x2c72, 0x2c72, classLetters | 0x0000},
+	{0x2c73, 0x2c74, classLetters | 0x0001},
+	{0x2c75, 0x2c75, classLetters | 0x0000},
+	{0x2c76, 0x2c7b, classLetters | 0x0001},
+	{0x2c7e, 0x2c7f, classLetters | 0x0000},
+	{0x2c80, 0x2c80, classLetters | 0x000a},
+	{0x2c81, 0x2c81, classLetters | 0x000b},
+	{0x2c82, 0x2c82, classLetters | 0x000a},
+	{0x2c83, 0x2c83, classLetters | 0x000b},
+	{0x2c84, 0x2c84, classLetters | 0x000a},
+	{0x2c85, 0x2c85, classLetters | 0x000b},
+	{0x2c86, 0x2c86, classLetters | 0x000a},
+	{0x2c87, 0x2c87, classLetters | 0x000b},
+	{0x2c88, 0x2c88, classLetters | 0x000a},
+	{0x2c89, 0x2c89, classLetters | 0x000b},
+	{0x2c8a, 0x2c8a, classLetters | 0x000a},
+	{0x2c8b, 0x2c8b, classLetters | 0x000b},
+	{0x2c8c, 0x2c8c, classLetters | 0x000a},
+	{0x2c8d, 0x2c8d, classLetters | 0x000b},
+	{0x2c8e, 0x2c8e, classLetters | 0x000a},
+	{0x2c8f, 0x2c8f, classLetters | 0x000b},
+	{0x2c90, 0x2c90, classLetters | 0x000a},
+	{0x2c91, 0x2c91, classLetters | 0x000b},
+	{0x2c92, 0x2c92, classLetters | 0x000a},
+	{0x2c93, 0x2c93, classLetters | 0x000b},
+	{0x2c94, 0x2c94, classLetters | 0x000a},
+	{0x2c95, 0x2c95, classLetters | 0x000b},
+	{0x2c96, 0x2c96, classLetters | 0x000a},
+	{0x2c97, 0x2c97, classLetters | 0x000b},
+	{0x2c98, 0x2c98, classLetters | 0x000a},
+	{0x2c99, 0x2c99, classLetters | 0x000b},
+	{0x2c9a, 0x2c9a, classLetters | 0x000a},
+	{0x2c9b, 0x2c9b, classLetters | 0x000b},
+	{0x2c9c, 0x2c9c, classLetters | 0x000a},
+	{0x2c9d, 0x2c9d, classLetters | 0x000b},
+	{0x2c9e, 0x2c9e, classLetters | 0x000a},
+	{0x2c9f, 0x2c9f, classLetters | 0x000b},
+	{0x2ca0, 0x2ca0, classLetters | 0x000a},
+	{0x2ca1, 0x2ca1, classLetters | 0x000b},
+	{0x2ca2, 0x2ca2, classLetters | 0x000a},
+	{0x2ca3, 0x2ca3, classLetters | 0x000b},
+	{0x2ca4, 0x2ca4, classLetters | 0x000a},
+	{0x2ca5, 0x2ca5, classLetters | 0x000b},
+	{0x2ca6, 0x2ca6, classLetters | 0x000a},
+	{0x2ca7, 0x2ca7, classLetters | 0x000b},
+	{0x2ca8, 0x2ca8, classLetters | 0x000a},
+	{0x2ca9, 0x2ca9, classLetters | 0x000b},
+	{0x2caa, 0x2caa, classLetters | 0x000a},
+	{0x2cab, 0x2cab, classLetters | 0x000b},
+	{0x2cac, 0x2cac, classLetters | 0x000a},
+	{0x2cad, 0x2cad, classLetters | 0x000b},
+	{0x2cae, 0x2cae, classLetters | 0x000a},
+	{0x2caf, 0x2caf, classLetters | 0x000b},
+	{0x2cb0, 0x2cb0, classLetters | 0x000a},
+	{0x2cb1, 0x2cb1, classLetters | 0x000b},
+	{0x2cb2, 0x2cb2, classLetters | 0x000a},
+	{0x2cb3, 0x2cb3, classLetters | 0x000b},
+	{0x2cb4, 0x2cb4, classLetters | 0x000a},
+	{0x2cb5, 0x2cb5, classLetters | 0x000b},
+	{0x2cb6, 0x2cb6, classLetters | 0x000a},
+	{0x2cb7, 0x2cb7, classLetters | 0x000b},
+	{0x2cb8, 0x2cb8, classLetters | 0x000a},
+	{0x2cb9, 0x2cb9, classLetters | 0x000b},
+	{0x2cba, 0x2cba, classLetters | 0x000a},
+	{0x2cbb, 0x2cbb, classLetters | 0x000b},
+	{0x2cbc, 0x2cbc, classLetters | 0x000a},
+	{0x2cbd, 0x2cbd, classLetters | 0x000b},
+	{0x2cbe, 0x2cbe, classLetters | 0x000a},
+	{0x2cbf, 0x2cbf, classLetters | 0x000b},
+	{0x2cc0, 0x2cc0, classLetters | 0x000a},
+	{0x2cc1, 0x2cc1, classLetters | 0x000b},
+	{0x2cc2, 0x2cc2, classLetters | 0x000a},
+	{0x2cc3, 0x2cc3, classLetters | 0x000b},
+	{0x2cc4, 0x2cc4, classLetters | 0x000a},
+	{0x2cc5, 0x2cc5, classLetters | 0x000b},
+	{0x2cc6, 0x2cc6, classLetters | 0x000a},
+	{0x2cc7, 0x2cc7, classLetters | 0x000b},
+	{0x2cc8, 0x2cc8, classLetters | 0x000a},
+	{0x2cc9, 0x2cc9, classLetters | 0x000b},
+	{0x2cca, 0x2cca, classLetters | 0x000a},
+	{0x2ccb, 0x2ccb, classLetters | 0x000b},
+	{0x2ccc, 0x2ccc, classLetters | 0x000a},
+	{0x2ccd, 0x2ccd, classLetters | 0x000b},
+	{0x2cce, 0x2cce, classLetters | 0x000a},
+	{0x2ccf, 0x2ccf, classLetters | 0x000b},
+	{0x2cd0, 0x2cd0, classLetters | 0x000a},
+	{0x2cd1, 0x2cd1, classLetters | 0x000b},
+	{0x2cd2, 0x2cd2, classLetters | 0x000a},
+	{0x2cd3, 0x2cd3, classLetters | 0x000b},
+	{0x2cd4, 0x2cd4, classLetters | 0x000a},
+	{0x2cd5, 0x2cd5, classLetters | 0x000b},
+	{0x2cd6, 0x2cd6, classLetters | 0x000a},
+	{0x2cd7, 0x2cd7, classLetters | 0x000b},
+	{0x2cd8, 0x2cd8, classLetters | 0x000a},
+	{0x2cd9, 0x2cd9, classLetters | 0x000b},
+	{0x2cda, 0x2cda, classLetters | 0x000a},
+	{0x2cdb, 0x2cdb, classLetters | 0x000b},
+	{0x2cdc, 0x2cdc, classLetters | 0x000a},
+	{0x2cdd, 0x2cdd, classLetters | 0x000b},
+	{0x2cde, 0x2cde, classLetters | 0x000a},
+	{0x2cdf, 0x2cdf, classLetters | 0x000b},
+	{0x2ce0, 0x2ce0, classLetters | 0x000a},
+	{0x2ce1, 0x2ce1, classLetters | 0x000b},
+	{0x2ce2, 0x2ce2, classLetters | 0x000a},
+	{0x2ce3, 0x2ce4, classLetters | 0x000b},
+	{0x2ceb, 0x2ceb, classLetters | 0x000a},
+	{0x2cec, 0x2cec, classLetters | 0x000b},
+	{0x2ced, 0x2ced, classLetters | 0x000a},
+	{0x2cee, 0x2cee, classLetters | 0x000b},
+	{0x2cf2, 0x2cf2, classLetters | 0x000a},
+	{0x2cf3, 0x2cf3, classLetters | 0x000b},
+	{0x2d00, 0x2d25, classLetters | 0x0009},
+	{0x2d27, 0x2d27, classLetters | 0x0009},
+	{0x2d2d, 0x2d2d, classLetters | 0x0009},
+	{0xa620, 0xa629, classDigits | 0xa620},
+	{0xa640, 0xa640, classLetters | 0x0004},
+	{0xa641, 0xa641, classLetters | 0x0005},
+	{0xa642, 0xa642, classLetters | 0x0004},
+	{0xa643, 0xa643, classLetters | 0x0005},
+	{0xa644, 0xa644, classLetters | 0x0004},
+	{0xa645, 0xa645, classLetters | 0x0005},
+	{0xa646, 0xa646, classLetters | 0x0004},
+	{0xa647, 0xa647, classLetters | 0x0005},
+	{0xa648, 0xa648, classLetters | 0x0004},
+	{0xa649, 0xa649, classLetters | 0x0005},
+	{0xa64a, 0xa64a, classLetters | 0x0004},
+	{0xa64b, 0xa64b, classLetters | 0x0005},
+	{0xa64c, 0xa64c, classLetters | 0x0004},
+	{0xa64d, 0xa64d, classLetters | 0x0005},
+	{0xa64e, 0xa64e, classLetters | 0x0004},
+	{0xa64f, 0xa64f, classLetters | 0x0005},
+	{0xa650, 0xa650, classLetters | 0x0004},
+	{0xa651, 0xa651, classLetters | 0x0005},
+	{0xa652, 0xa652, classLetters | 0x0004},
+	{0xa653, 0xa653, classLetters | 0x0005},
+	{0xa654, 0xa654, classLetters | 0x0004},
+	{0xa655, 0xa655, classLetters | 0x0005},
+	{0xa656, 0xa656, classLetters | 0x0004},
+	{0xa657, 0xa657, classLetters | 0x0005},
+	{0xa658, 0xa658, classLetters | 0x0004},
+	{0xa659, 0xa659, classLetters | 0x0005},
+	{0xa65a, 0xa65a, classLetters | 0x0004},
+	{0xa65b, 0xa65b, classLetters | 0x0005},
+	{0xa65c, 0xa65c, classLetters | 0x0004},
+	{0xa65d, 0xa65d, classLetters | 0x0005},
+	{0xa65e, 0xa65e, classLetters | 0x0004},
+	{0xa65f, 0xa65f, classLetters | 0x0005},
+	{0xa660, 0xa660, classLetters | 0x0004},
+	{0xa661, 0xa661, classLetters | 0x0005},
+	{0xa662, 0xa662, classLetters | 0x0004},
+	{0xa663, 0xa663, classLetters | 0x0005},
+	{0xa664, 0xa664, classLetters | 0x0004},
+	{0xa665, 0xa665, classLetters | 0x0005},
+	{0xa666, 0xa666, classLetters | 0x0004},
+	{0xa667, 0xa667, classLetters | 0x0005},
+	{0xa668, 0xa668, classLetters | 0x0004},
+	{0xa669, 0xa669, classLetters | 0x0005},
+	{0xa66a, 0xa66a, classLetters | 0x0004},
+	{0xa66b, 0xa66b, classLetters | 0x0005},
+	{0xa66c, 0xa66c, classLetters | 0x0004},
+	{0xa66d, 0xa66d, classLetters | 0x0005},
+	{0xa680, 0xa680, classLetters | 0x0004},
+	{0xa681, 0xa681, classLetters | 0x0005},
+	{0xa682, 0xa682, classLetters | 0x0004},
+	{0xa683, 0xa683, classLetters | 0x0005},
+	{0xa684, 0xa684, classLetters | 0x0004},
+	{0xa685, 0xa685, classLetters | 0x0005},
+	{0xa686, 0xa686, classLetters | 0x0004},
+	{0xa687, 0xa687, classLetters | 0x0005},
+	{0xa688, 0xa688, classLetters | 0x0004},
+	{0xa689, 0xa689, classLetters | 0x0005},
+	{0xa68a, 0xa68a, classLetters | 0x0004},
+	{0xa68b, 0xa68b, classLetters | 0x0005},
+	{0xa68c, 0xa68c, classLetters | 0x0004},
+	{0xa68d, 0xa68d, classLetters | 0x0005},
+	{0xa68e, 0xa68e, classLetters | 0x0004},
+	{0xa68f, 0xa68f, classLetters | 0x0005},
+	{0xa690, 0xa690, classLetters | 0x0004},
+	{0xa691, 0xa691, classLetters | 0x0005},
+	{0xa692, 0xa692, classLetters | 0x0004},
+	{0xa693, 0xa693, classLetters | 0x0005},
+	{0xa694, 0xa694, classLetters | 0x0004},
+	{0xa695, 0xa695, classLetters | 0x0005},
+	{0xa696, 0xa696, classLetters | 0x0004},
+	{0xa697, 0xa697, classLetters | 0x0005},
+	{0xa698, 0xa698, classLetters | 0x0004},
+	{0xa699, 0xa699, classLetters | 0x0005},
+	{0xa69a, 0xa69a, classLetters | 0x0004},
+	{0xa69b, 0xa69b, classLetters | 0x0005},
+	{0xa722, 0xa722, classLetters | 0x0000},
+	{0xa723, 0xa723, classLetters | 0x0001},
+	{0xa724, 0xa724, classLetters | 0x0000},
+	{0xa725, 0xa725, classLetters | 0x0001},
+	{0xa726, 0xa726, classLetters | 0x0000},
+	{0xa727, 0xa727, classLetters | 0x0001},
+	{0xa728, 0xa728, classLetters | 0x0000},
+	{0xa729, 0xa729, classLetters | 0x0001},
+	{0xa72a, 0xa72a, classLetters | 0x0000},
+	{0xa72b, 0xa72b, classLetters | 0x0001},
+	{0xa72c, 0xa72c, classLetters | 0x0000},
+	{0xa72d, 0xa72d, classLetters | 0x0001},
+	{0xa72e, 0xa72e, classLetters | 0x0000},
+	{0xa72f, 0xa731, classLetters | 0x0001},
+	{0xa732, 0xa732, classLetters | 0x0000},
+	{0xa733, 0xa733, classLetters | 0x0001},
+	{0xa734, 0xa734, classLetters | 0x0000},
+	{0xa735, 0xa735, classLetters | 0x0001},
+	{0xa736, 0xa736, classLetters | 0x0000},
+	{0xa737, 0xa737, classLetters | 0x0001},
+	{0xa738, 0xa738, classLetters | 0x0000},
+	{0xa739, 0xa739, classLetters | 0x0001},
+	{0xa73a, 0xa73a, classLetters | 0x0000},
+	{0xa73b, 0xa73b, classLetters | 0x0001},
+	{0xa73c, 0xa73c, classLetters | 0x0000},
+	{0xa73d, 0xa73d, classLetters | 0x0001},
+	{0xa73e, 0xa73e, classLetters | 0x0000},
+	{0xa73f, 0xa73f, classLetters | 0x0001},
+	{0xa740, 0xa740, classLetters | 0x0000},
+	{0xa741, 0xa741, classLetters | 0x0001},
+	{0xa742, 0xa742, classLetters | 0x0000},
+	{0xa743, 0xa743, classLetters | 0x0001},
+	{0xa744, 0xa744, classLetters | 0x0000},
+	{0xa745, 0xa745, classLetters | 0x0001},
+	{0xa746, 0xa746, classLetters | 0x0000},
+	{0xa747, 0xa747, classLetters | 0x0001},
+	{0xa748, 0xa748, classLetters | 0x0000},
+	{0xa749, 0xa749, classLetters | 0x0001},
+	{0xa74a, 0xa74a, classLetters | 0x0000},
+	{0xa74b, 0xa74b, classLetters | 0x0001},
+	{0xa74c, 0xa74c, classLetters | 0x0000},
+	{0xa74d, 0xa74d, classLetters | 0x0001},
+	{0xa74e, 0xa74e, classLetters | 0x0000},
+	{0xa74f, 0xa74f, classLetters | 0x0001},
+	{0xa750, 0xa750, classLetters | 0x0000},
+	{0xa751, 0xa751, classLetters | 0x0001},
+	{0xa752, 0xa752, classLetters | 0x0000},
+	{0xa753, 0xa753, classLetters | 0x0001},
+	{0xa754, 0xa754, classLetters | 0x0000},
+	{0xa755, 0xa755, classLetters | 0x0001},
+	{0xa756, 0xa756, classLetters | 0x0000},
+	{0xa757, 0xa757, classLetters | 0x0001},
+	{0xa758, 0xa758, classLetters | 0x0000},
+	{0xa759, 0xa759, classLetters | 0x0001},
+	{0xa75a, 0xa75a, classLetters | 0x0000},
+	{0xa75b, 0xa75b, classLetters | 0x0001},
+	{0xa75c, 0xa75c, classLetters | 0x0000},
+	{0xa75d, 0xa75d, classLetters | 0x0001},
+	{0xa75e, 0xa75e, classLetters | 0x0000},
+	{0xa75f, 0xa75f, classLetters | 0x0001},
+	{0xa760, 0xa760, classLetters | 0x0000},
+	{0xa761, 0xa761, classLetters | 0x0001},
+	{0xa762, 0xa762, classLetters | 0x0000},
+	{0xa763, 0xa763, classLetters | 0x0001},
+	{0xa764, 0xa764, classLetters | 0x0000},
+	{0xa765, 0xa765, classLetters | 0x0001},
+	{0xa766, 0xa766, classLetters | 0x0000},
+	{0xa767, 0xa767, classLetters | 0x0001},
+	{0xa768, 0xa768, classLetters | 0x0000},
+	{0xa769, 0xa769, classLetters | 0x0001},
+	{0xa76a, 0xa76a, classLetters | 0x0000},
+	{0xa76b, 0xa76b, classLetters | 0x0001},
+	{0xa76c, 0xa76c, classLetters | 0x0000},
+	{0xa76d, 0xa76d, classLetters | 0x0001},
+	{0xa76e, 0xa76e, classLetters | 0x0000},
+	{0xa76f, 0xa76f, classLetters | 0x0001},
+	{0xa771, 0xa778, classLetters | 0x0001},
+	{0xa779, 0xa779, classLetters | 0x0000},
+	{0xa77a, 0xa77a, classLetters | 0x0001},
+	{0xa77b, 0xa77b, classLetters | 0x0000},
+	{0xa77c, 0xa77c, classLetters | 0x0001},
+	{0xa77d, 0xa77e, classLetters | 0x0000},
+	{0xa77f, 0xa77f, classLetters | 0x0001},
+	{0xa780, 0xa780, classLetters | 0x0000},
+	{0xa781, 0xa781, classLetters | 0x0001},
+	{0xa782, 0xa782, classLetters | 0x0000},
+	{0xa783, 0xa783, classLetters | 0x0001},
+	{0xa784, 0xa784, classLetters | 0x0000},
+	{0xa785, 0xa785, classLetters | 0x0001},
+	{0xa786, 0xa786, classLetters | 0x0000},
+	{0xa787, 0xa787, classLetters | 0x0001},
+	{0xa78b, 0xa78b, classLetters | 0x0000},
+	{0xa78c, 0xa78c, classLetters | 0x0001},
+	{0xa78d, 0xa78d, classLetters | 0x0000},
+	{0xa78e, 0xa78e, classLetters | 0x0001},
+	{0xa790, 0xa790, classLetters | 0x0000},
+	{0xa791, 0xa791, classLetters | 0x0001},
+	{0xa792, 0xa792, classLetters | 0x0000},
+	{0xa793, 0xa795, classLetters | 0x0001},
+	{0xa796, 0xa796, classLetters | 0x0000},
+	{0xa797, 0xa797, classLetters | 0x0001},
+	{0xa798, 0xa798, classLetters | 0x0000},
+	{0xa799, 0xa799, classLetters | 0x0001},
+	{0xa79a, 0xa79a, classLetters | 0x0000},
+	{0xa79b, 0xa79b, classLetters | 0x0001},
+	{0xa79c, 0xa79c, classLetters | 0x0000},
+	{0xa79d, 0xa79d, classLetters | 0x0001},
+	{0xa79e, 0xa79e, classLetters | 0x0000},
+	{0xa79f, 0xa79f, classLetters | 0x0001},
+	{0xa7a0, 0xa7a0, classLetters | 0x0000},
+	{0xa7a1, 0xa7a1, classLetters | 0x0001},
+	{0xa7a2, 0xa7a2, classLetters | 0x0000},
+	{0xa7a3, 0xa7a3, classLetters | 0x0001},
+	{0xa7a4, 0xa7a4, classLetters | 0x0000},
+	{0xa7a5, 0xa7a5, classLetters | 0x0001},
+	{0xa7a6, 0xa7a6, classLetters | 0x0000},
+	{0xa7a7, 0xa7a7, classLetters | 0x0001},
+	{0xa7a8, 0xa7a8, classLetters | 0x0000},
+	{0xa7a9, 0xa7a9, classLetters | 0x0001},
+	{0xa7aa, 0xa7ae, classLetters | 0x0000},
+	{0xa7af, 0xa7af, classLetters | 0x0001},
+	{0xa7b0, 0xa7b4, classLetters | 0x0000},
+	{0xa7b5, 0xa7b5, classLetters | 0x0001},
+	{0xa7b6, 0xa7b6, classLetters | 0x0000},
+	{0xa7b7, 0xa7b7, classLetters | 0x0001},
+	{0xa7b8, 0xa7b8, classLetters | 0x0000},
+	{0xa7b9, 0xa7b9, classLetters | 0x0001},
+	{0xa7ba, 0xa7ba, classLetters | 0x0000},
+	{0xa7bb, 0xa7bb, classLetters | 0x0001},
+	{0xa7bc, 0xa7bc, classLetters | 0x0000},
+	{0xa7bd, 0xa7bd, classLetters | 0x0001},
+	{0xa7be, 0xa7be, classLetters | 0x0000},
+	{0xa7bf, 0xa7bf, classLetters | 0x0001},
+	{0xa7c2, 0xa7c2, classLetters | 0x0000},
+	{0xa7c3, 0xa7c3, classLetters | 0x0001},
+	{0xa7c4, 0xa7c7, classLetters | 0x0000},
+	{0xa7c8, 0xa7c8, classLetters | 0x0001},
+	{0xa7c9, 0xa7c9, classLetters | 0x0000},
+	{0xa7ca, 0xa7ca, classLetters | 0x0001},
+	{0xa7f5, 0xa7f5, classLetters | 0x0000},
+	{0xa7f6, 0xa7f6, classLetters | 0x0001},
+	{0xa7fa, 0xa7fa, classLetters | 0x0001},
+	{0xa8d0, 0xa8d9, classDigits | 0xa8d0},
+	{0xa900, 0xa909, classDigits | 0xa900},
+	{0xa9d0, 0xa9d9, classDigits | 0xa9d0},
+	{0xa9f0, 0xa9f9, classDigits | 0xa9f0},
+	{0xaa50, 0xaa59, classDigits | 0xaa50},
+	{0xab30, 0xab5a, classLetters | 0x0001},
+	{0xab60, 0xab64, classLetters | 0x0001},
+	{0xab65, 0xab65, classLetters | 0x0003},
+	{0xab66, 0xab68, classLetters | 0x0001},
+	{0xab70, 0xabbf, classLetters | 0x000f},
+	{0xabf0, 0xabf9, classDigits | 0xabf0},
+	{0xfb00, 0xfb06, classLetters | 0x0001},
+	{0xfb13, 0xfb17, classLetters | 0x0007},
+	{0xff10, 0xff19, classDigits | 0xff10},
+	{0xff21, 0xff3a, classLetters | 0x0000},
+	{0xff41, 0xff5a, classLetters | 0x0001},
+	{0x10400, 0x10427, classLetters | 0x0110},
+	{0x10428, 0x1044f, classLetters | 0x0111},
+	{0x104a0, 0x104a9, classDigits | 0x104a0},
+	{0x104b0, 0x104d3, classLetters | 0x0112},
+	{0x104d8, 0x104fb, classLetters | 0x0113},
+	{0x10c80, 0x10cb2, classLetters | 0x0114},
+	{0x10cc0, 0x10cf2, classLetters | 0x0115},
+	{0x10d30, 0x10d39, classDigits | 0x10d30},
+	{0x11066, 0x1106f, classDigits | 0x11066},
+	{0x110f0, 0x110f9, classDigits | 0x110f0},
+	{0x11136, 0x1113f, classDigits | 0x11136},
+	{0x111d0, 0x111d9, classDigits | 0x111d0},
+	{0x112f0, 0x112f9, classDigits | 0x112f0},
+	{0x11450, 0x11459, classDigits | 0x11450},
+	{0x114d0, 0x114d9, classDigits | 0x114d0},
+	{0x11650, 0x11659, classDigits | 0x11650},
+	{0x116c0, 0x116c9, classDigits | 0x116c0},
+	{0x11730, 0x11739, classDigits | 0x11730},
+	{0x118a0, 0x118bf, classLetters | 0x0116},
+	{0x118c0, 0x118df, classLetters | 0x0117},
+	{0x118e0, 0x118e9, classDigits | 0x118e0},
+	{0x11950, 0x11959, classDigits | 0x11950},
+	{0x11c50, 0x11c59, classDigits | 0x11c50},
+	{0x11d50, 0x11d59, classDigits | 0x11d50},
+	{0x11da0, 0x11da9, classDigits | 0x11da0},
+	{0x16a60, 0x16a69, classDigits | 0x16a60},
+	{0x16b50, 0x16b59, classDigits | 0x16b50},
+	{0x16e40, 0x16e5f, classLetters | 0x0118},
+	{0x16e60, 0x16e7f, classLetters | 0x0119},
+	{0x1d7ce, 0x1d7d7, classDigits | 0x1d7ce},
+	{0x1d7d8, 0x1d7e1, classDigits | 0x1d7d8},
+	{0x1d7e2, 0x1d7eb, classDigits | 0x1d7e2},
+	{0x1d7ec, 0x1d7f5, classDigits | 0x1d7ec},
+	{0x1d7f6, 0x1d7ff, classDigits | 0x1d7f6},
+	{0x1e140, 0x1e149, classDigits | 0x1e140},
+	{0x1e2f0, 0x1e2f9, classDigits | 0x1e2f0},
+	{0x1e900, 0x1e921, classLetters | 0x011a},
+	{0x1e922, 0x1e943, classLetters | 0x011b},
+	{0x1e950, 0x1e959, classDigits | 0x1e950},
+	{0x1fbf0, 0x1fbf9, classDigits | 0x1fbf0},
+}
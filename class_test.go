@@ -0,0 +1,175 @@
+package ubiq
+
+import (
+	"testing"
+)
+
+func TestClassPreserving(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cp, err := NewClassPreserving(ff1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"John Smith",
+		"123 Main St., Apt. 4B",
+		"Call 555-0100 after 5pm!",
+		"Zoë O'Brien-Müller",
+		"Ærøskøbing Østergade",
+		"Σωκράτης Παπαδόπουλος",
+		"Фёдор Достоевский",
+		"٠١٢٣٤٥ 0123 ۰۱۲",
+		"東京都 Chiyoda-ku",
+		"ab",
+		"",
+	} {
+		CT, err := cp.Encrypt(PT, []byte("notes"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		X, Y := []rune(PT), []rune(CT)
+		if len(X) != len(Y) {
+			t.Fatal(CT)
+		}
+		for i := range X {
+			c := classOf(X[i])
+			if classOf(Y[i]) != c || (c < 0 && X[i] != Y[i]) {
+				t.Fatal(PT + " -> " + CT)
+			}
+		}
+
+		out, err := cp.Decrypt(CT, []byte("notes"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestClassPreservingLayoutTweak(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	cp, _ := NewClassPreserving(ff1)
+
+	// the same digits in a different layout are
+	// encrypted under a different tweak
+	a, _ := cp.Encrypt("1234567890", nil)
+	b, _ := cp.Encrypt("12345-67890", nil)
+
+	if a == b[:5]+b[6:] {
+		t.FailNow()
+	}
+}
+
+func TestClassPreservingNonASCII(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	cp, _ := NewClassPreserving(ff1)
+
+	for _, c := range []struct {
+		in    string
+		cased bool
+	}{
+		{"Zoë Müller", true},
+		{"Ελένη", true},
+		{"١٢٣٤٥٦", true},
+		// uncased letters are passed through
+		{"שלום עולם", false},
+	} {
+		CT, err := cp.Encrypt(c.in, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// the number of non-ASCII characters left unchanged
+		n, same := 0, 0
+		X, Y := []rune(c.in), []rune(CT)
+		for i := range X {
+			if classOf(X[i]) != classOf(Y[i]) {
+				t.Fatal(c.in + " -> " + CT)
+			}
+			if X[i] >= 0x80 {
+				n++
+				if X[i] == Y[i] {
+					same++
+				}
+				if X[i] < 0x10000 && Y[i] >= 0x10000 {
+					t.Fatal(c.in + " -> " + CT)
+				}
+			}
+		}
+
+		if c.cased && same == n || !c.cased && same != n {
+			t.Fatal(c.in + " -> " + CT)
+		}
+
+		out, err := cp.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != c.in {
+			t.Fatal(out + " != " + c.in)
+		}
+	}
+
+	// each class contains only characters of that class
+	for _, r := range "ëÜλΣжЖ٣𐐨" {
+		c := classOf(r)
+		alpha := classAlphabet(c)
+		if alpha.PosOf(r) < 0 || alpha.Len() < 2 {
+			t.Fatal(string(r))
+		}
+		for i := 0; i < alpha.Len(); i++ {
+			if classOf(alpha.ValAt(i)) != c {
+				t.Fatal(string(r), string(alpha.ValAt(i)))
+			}
+		}
+	}
+}
+
+func TestClassPreservingKnownAnswer(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	cp, _ := NewClassPreserving(ff1)
+
+	// the classes are fixed, so these must not
+	// change, regardless of the version of go
+	for PT, CT := range map[string]string{
+		"ë":          "ṷ",
+		"Zoë Müller": "Zrē Pųlbdu",
+		"Фёдор":      "Ҥҋѻкꙇ",
+		"٣٤٥":        "٨٨٩",
+	} {
+		if out, err := cp.Encrypt(PT, nil); err != nil {
+			t.Fatal(err)
+		} else if out != CT {
+			t.Fatal(PT + " -> " + out + " != " + CT)
+		}
+	}
+}
+
+func TestClassTable(t *testing.T) {
+	if classUnicodeVersion != "13.0.0" {
+		t.Fatal(classUnicodeVersion)
+	}
+
+	// runs are sorted, do not overlap and lie outside of ASCII
+	prev := rune(0x7f)
+	for _, r := range classTable {
+		if r.lo <= prev || r.hi < r.lo || r.class < len(charClasses) {
+			t.Fatalf("%#x-%#x", r.lo, r.hi)
+		}
+		prev = r.hi
+	}
+
+	// characters assigned after the version of the
+	// table are passed through, e.g. U+1DF08
+	if c := classOf(0x1df08); c >= 0 {
+		t.Fatal(c)
+	}
+}
@@ -0,0 +1,211 @@
+package ubiq
+
+import (
+	"errors"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Context structure for encrypting geographic coordinates such
+// that the results remain within a bounding box
+type Coordinate struct {
+	ff1 *FF1
+
+	prec  int
+	joint bool
+	// the bounds of the box, inclusive, as integers
+	// scaled by 10**prec
+	lat, lon [2]int64
+}
+
+// Allocate a new coordinate context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// @prec is the number of decimal places to which latitudes and
+// longitudes are rounded, and must be between 0 and 9
+//
+// the bounding box is given by @minLat, @maxLat, @minLon and @maxLon
+// in degrees, inclusive. the box may not cross the antimeridian.
+//
+// if @joint is true, the latitude and longitude are encrypted
+// together as a single value; otherwise, each is encrypted on
+// its own, and inputs sharing a latitude (or longitude) will
+// also share one after encryption
+func NewCoordinate(ff1 *FF1, prec int,
+	minLat, maxLat, minLon, maxLon float64, joint bool) (
+	*Coordinate, error) {
+	if prec < 0 || prec > 9 {
+		return nil, errors.New("invalid precision")
+	}
+
+	this := &Coordinate{ff1: ff1, prec: prec, joint: joint}
+
+	if minLat < -90 || maxLat > 90 || minLon < -180 || maxLon > 180 {
+		return nil, errors.New("invalid bounding box")
+	}
+
+	this.lat[0], this.lat[1] = this.scale(minLat), this.scale(maxLat)
+	this.lon[0], this.lon[1] = this.scale(minLon), this.scale(maxLon)
+
+	if this.lat[0] >= this.lat[1] || this.lon[0] >= this.lon[1] {
+		return nil, errors.New("invalid bounding box")
+	}
+
+	return this, nil
+}
+
+// convert degrees to an integer at the configured precision
+func (this *Coordinate) scale(v float64) int64 {
+	return int64(math.Round(v * math.Pow10(this.prec)))
+}
+
+func (this *Coordinate) unscale(n int64) float64 {
+	return float64(n) / math.Pow10(this.prec)
+}
+
+// format a scaled integer at the configured precision
+func (this *Coordinate) format(n int64) string {
+	s := strconv.FormatInt(n, 10)
+
+	neg := n < 0
+	if neg {
+		s = s[1:]
+	}
+
+	if this.prec > 0 {
+		if len(s) <= this.prec {
+			s = strings.Repeat("0", this.prec-len(s)+1) + s
+		}
+		s = s[:len(s)-this.prec] + "." + s[len(s)-this.prec:]
+	}
+
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// encrypt the scaled values @lat and @lon
+func (this *Coordinate) cipher(lat, lon int64, T []byte, enc bool) (
+	int64, int64, error) {
+	if lat < this.lat[0] || lat > this.lat[1] ||
+		lon < this.lon[0] || lon > this.lon[1] {
+		return 0, 0, errors.New("coordinate outside of bounding box")
+	}
+
+	ml := big.NewInt(this.lat[1] - this.lat[0] + 1)
+	mo := big.NewInt(this.lon[1] - this.lon[0] + 1)
+
+	nl := big.NewInt(lat - this.lat[0])
+	no := big.NewInt(lon - this.lon[0])
+
+	if this.joint {
+		// n = lat * (number of longitudes) + lon
+		n := new(big.Int).Mul(nl, mo)
+		n.Add(n, no)
+
+		n, err := this.ff1.cycleWalk(n, new(big.Int).Mul(ml, mo), T, enc)
+		if err != nil {
+			return 0, 0, err
+		}
+
+		nl.DivMod(n, mo, no)
+	} else {
+		if T == nil {
+			T = this.ff1.ctx.twk
+		}
+
+		// separate tweaks for the latitude and longitude
+		twk := make([]byte, len(T)+1)
+		copy(twk, T)
+
+		var err error
+
+		twk[len(T)] = 'A'
+		if nl, err = this.ff1.cycleWalk(nl, ml, twk, enc); err != nil {
+			return 0, 0, err
+		}
+
+		twk[len(T)] = 'O'
+		if no, err = this.ff1.cycleWalk(no, mo, twk, enc); err != nil {
+			return 0, 0, err
+		}
+	}
+
+	return nl.Int64() + this.lat[0], no.Int64() + this.lon[0], nil
+}
+
+// Encrypt the latitude @lat and longitude @lon, in degrees, with
+// the tweak @T. the inputs are rounded to the configured precision
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Coordinate) Encrypt(lat, lon float64, T []byte) (
+	float64, float64, error) {
+	a, o, err := this.cipher(this.scale(lat), this.scale(lon), T, true)
+	return this.unscale(a), this.unscale(o), err
+}
+
+// Decrypt the latitude @lat and longitude @lon, in degrees,
+// with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Coordinate) Decrypt(lat, lon float64, T []byte) (
+	float64, float64, error) {
+	a, o, err := this.cipher(this.scale(lat), this.scale(lon), T, false)
+	return this.unscale(a), this.unscale(o), err
+}
+
+func (this *Coordinate) cipherString(X string, T []byte, enc bool) (
+	string, error) {
+	a, o, ok := strings.Cut(X, ",")
+	if !ok {
+		return "", errors.New("invalid coordinate")
+	}
+
+	// preserve any space following the comma
+	sep := ","
+	if strings.HasPrefix(o, " ") {
+		sep = ", "
+		o = o[1:]
+	}
+
+	lat, err := strconv.ParseFloat(a, 64)
+	if err != nil {
+		return "", err
+	}
+	lon, err := strconv.ParseFloat(o, 64)
+	if err != nil {
+		return "", err
+	}
+
+	la, lo, err := this.cipher(this.scale(lat), this.scale(lon), T, enc)
+	if err != nil {
+		return "", err
+	}
+
+	return this.format(la) + sep + this.format(lo), nil
+}
+
+// Encrypt a coordinate @X of the form "latitude,longitude", with
+// the tweak @T. the result is rendered at the configured precision
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Coordinate) EncryptString(X string, T []byte) (string, error) {
+	return this.cipherString(X, T, true)
+}
+
+// Decrypt a coordinate @X of the form "latitude,longitude",
+// with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Coordinate) DecryptString(X string, T []byte) (string, error) {
+	return this.cipherString(X, T, false)
+}
@@ -0,0 +1,83 @@
+package ubiq
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func testCoordinate(t *testing.T, joint bool) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// roughly, the continental united states
+	c, err := NewCoordinate(ff1, 5, 24.5, 49.4, -124.8, -66.9, joint)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"37.77493,-122.41942",
+		"40.71278, -74.00597",
+		"24.50000,-66.90000",
+		"49.40000,-124.80000",
+	} {
+		CT, err := c.EncryptString(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		a, o, _ := strings.Cut(CT, ",")
+		lat, _ := strconv.ParseFloat(a, 64)
+		lon, _ := strconv.ParseFloat(strings.TrimSpace(o), 64)
+		if lat < 24.5 || lat > 49.4 || lon < -124.8 || lon > -66.9 {
+			t.Fatal(CT)
+		}
+
+		out, err := c.DecryptString(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	lat, lon, err := c.Encrypt(30.26715, -97.74306, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lat, lon, err = c.Decrypt(lat, lon, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.scale(lat) != 3026715 || c.scale(lon) != -9774306 {
+		t.Fatal(lat, lon)
+	}
+
+	if _, err := c.EncryptString("51.50735,-0.12776", nil); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestCoordinate(t *testing.T) {
+	testCoordinate(t, false)
+}
+
+func TestCoordinateJoint(t *testing.T) {
+	testCoordinate(t, true)
+}
+
+func TestCoordinateFormat(t *testing.T) {
+	c := &Coordinate{prec: 4}
+
+	for s, n := range map[string]int64{
+		"0.0005": 5, "-0.0500": -500, "-122.4194": -1224194, "0.0000": 0,
+	} {
+		if out := c.format(n); out != s {
+			t.Fatal(out + " != " + s)
+		}
+	}
+}
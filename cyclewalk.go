@@ -0,0 +1,128 @@
+package ubiq
+
+import (
+	"errors"
+	"math/big"
+
+	"golang.org/x/exp/slices"
+)
+
+// domains with at most this many values are permuted by ranking
+// (see rankPermute) rather than by cycle walking. the threshold
+// balances the cost of the two: ranking requires one encryption
+// per value in the domain, whereas walking requires, on average,
+// 2**20 / m encryptions for a domain of m values
+const cycleWalkRankMax = 1 << 10
+
+// encrypt or decrypt (depending on @enc) the integer @x, which must be
+// in the range [0, @m), producing another integer in the same range.
+//
+// the integer is treated as a binary numeral string just long enough
+// to hold any value less than @m (but no shorter than the minimum
+// length allowed for radix 2) and is repeatedly passed through FF1
+// until the result falls within the range. because the binary domain
+// is less than twice the size of the range (for ranges of at least
+// 2**20 values), the expected number of iterations is less than two.
+//
+// for smaller ranges, the expected number of iterations grows in
+// inverse proportion to the size of the range, so ranges of no more
+// than cycleWalkRankMax values are handled by rankPermute instead.
+// as a result, no call requires more than about 2**10 encryptions
+// on average, regardless of the size of the range
+func (this *FF1) cycleWalk(x, m *big.Int, T []byte, enc bool) (
+	*big.Int, error) {
+	if m.Cmp(big.NewInt(2)) < 0 {
+		return nil, errors.New("invalid domain size")
+	} else if x.Sign() < 0 || x.Cmp(m) >= 0 {
+		return nil, errors.New("value outside of domain")
+	}
+
+	n := new(big.Int).Sub(m, big.NewInt(1)).BitLen()
+	if mintxt := minTextLen(2); n < mintxt {
+		n = mintxt
+	}
+
+	if m.Cmp(big.NewInt(cycleWalkRankMax)) <= 0 {
+		return this.rankPermute(x.Int64(), m.Int64(), n, T, enc)
+	}
+
+	y := new(big.Int).Set(x)
+	for {
+		if err := this.cipherBinary(y, n, T, enc); err != nil {
+			return nil, err
+		}
+
+		if y.Cmp(m) < 0 {
+			break
+		}
+	}
+
+	return y, nil
+}
+
+// encrypt or decrypt (depending on @enc) @y, in place, as
+// a binary numeral string of @n bits using FF1
+func (this *FF1) cipherBinary(y *big.Int, n int, T []byte, enc bool) error {
+	ctx := this.ctx
+
+	v := uint(n - n/2)
+
+	ctx.nA.Rsh(y, v)
+	ctx.nB.And(y, bitMask(v))
+
+	err := this.cipherInts(2, n, minTextLen(2), T, enc)
+	if err != nil {
+		return err
+	}
+
+	y.Lsh(ctx.nA, v)
+	y.Or(y, ctx.nB)
+
+	return nil
+}
+
+// encrypt or decrypt (depending on @enc) @x within the small
+// range [0, @m). each value in the range is encrypted as a binary
+// numeral string of @n bits, and a value is encrypted to the rank
+// of its encryption among those of all values in the range. since
+// FF1 is a permutation, no two values share an encryption, and the
+// ranking is itself a permutation of the range
+func (this *FF1) rankPermute(x, m int64, n int, T []byte, enc bool) (
+	*big.Int, error) {
+	type entry struct {
+		val int64
+		key uint64
+	}
+
+	E := make([]entry, m)
+	y := new(big.Int)
+	for i := range E {
+		y.SetInt64(int64(i))
+		if err := this.cipherBinary(y, n, T, true); err != nil {
+			return nil, err
+		}
+		E[i] = entry{val: int64(i), key: y.Uint64()}
+	}
+
+	slices.SortFunc(E, func(a, b entry) int {
+		if a.key < b.key {
+			return -1
+		} else if a.key > b.key {
+			return 1
+		}
+		return 0
+	})
+
+	if !enc {
+		return big.NewInt(E[x].val), nil
+	}
+
+	for i, e := range E {
+		if e.val == x {
+			return big.NewInt(int64(i)), nil
+		}
+	}
+
+	// not reachable; every value in the range is present
+	return nil, errors.New("value outside of domain")
+}
@@ -0,0 +1,243 @@
+package ubiq
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"time"
+)
+
+// Granularity determines the smallest unit of time
+// that is changed by encrypting a time value
+type Granularity int
+
+const (
+	// dates are encrypted; the time elapsed since the
+	// start of the day is preserved
+	GranularityDay Granularity = iota
+	// dates and times are encrypted to the second;
+	// fractions of a second are preserved
+	GranularitySecond
+)
+
+// Preserve determines the leading components of a time
+// value that are left unchanged by encryption
+type Preserve int
+
+const (
+	PreserveNone Preserve = iota
+	// the year is preserved
+	PreserveYear
+	// the year and month are preserved
+	PreserveMonth
+	// the year, month and day are preserved; this is
+	// only meaningful in combination with GranularitySecond
+	PreserveDay
+)
+
+const secondsPerDay = 24 * 60 * 60
+
+// Context structure for encrypting dates and times such that
+// the result is a valid time within a given range
+type DateTime struct {
+	ff1 *FF1
+
+	gran Granularity
+	keep Preserve
+	// the bounds of the range, inclusive, in units
+	// determined by the granularity
+	min, max int64
+}
+
+// Allocate a new date/time context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// @min and @max are the earliest and latest allowable times
+// (inclusive). with GranularityDay, only the dates of these
+// values are considered
+//
+// @gran specifies whether days or seconds are encrypted, and
+// @keep specifies which leading components of the input are left
+// unchanged. when components are preserved, the output is limited
+// to the portion of [@min, @max] that shares those components with
+// the input, and the preserved components are appended to the tweak.
+//
+// all components are determined in the location of the input. with
+// GranularityDay, the time elapsed since the start of the day, rather
+// than the time shown on the clock, is carried over to the new date,
+// so that the time exists on that date and can be decrypted even if
+// the clocks are changed on either date. as a result, the clock time
+// of the output differs from that of the input when the clocks are
+// changed earlier in one of the days but not the other, and inputs
+// late in the day for which the resulting date is too short (e.g. 11:30
+// pm carried over to a 23-hour day) are rejected
+func NewDateTime(ff1 *FF1, min, max time.Time,
+	gran Granularity, keep Preserve) (*DateTime, error) {
+	this := &DateTime{ff1: ff1, gran: gran, keep: keep}
+
+	if gran != GranularityDay && gran != GranularitySecond {
+		return nil, errors.New("invalid granularity")
+	} else if keep < PreserveNone || keep > PreserveDay ||
+		(keep == PreserveDay && gran == GranularityDay) {
+		return nil, errors.New("invalid preserved components")
+	}
+
+	this.min = this.units(min)
+	this.max = this.units(max)
+	if this.min >= this.max {
+		return nil, errors.New("invalid time range")
+	}
+
+	return this, nil
+}
+
+// convert @t to the number of days or seconds since the epoch
+func (this *DateTime) units(t time.Time) int64 {
+	if this.gran == GranularityDay {
+		y, m, d := t.Date()
+		return time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix() /
+			secondsPerDay
+	}
+
+	return t.Unix()
+}
+
+// convert @u days or seconds since the epoch back to a time in
+// the same location as @t, carrying over the components of @t
+// that are finer than the granularity
+func (this *DateTime) time(u int64, t time.Time) (time.Time, error) {
+	if this.gran == GranularityDay {
+		// the time of day is carried over as the time elapsed
+		// since the start of the day rather than as the time on
+		// the clock, which may not exist (or may occur twice) on
+		// a day on which the clocks are changed
+		y, m, d := t.Date()
+		e := t.Sub(time.Date(y, m, d, 0, 0, 0, 0, t.Location()))
+
+		y, m, d = time.Unix(u*secondsPerDay, 0).UTC().Date()
+		r := time.Date(y, m, d, 0, 0, 0, 0, t.Location()).Add(e)
+
+		// the elapsed time may exceed the length of a short day
+		if ry, rm, rd := r.Date(); ry != y || rm != m || rd != d {
+			return time.Time{}, errors.New(
+				"time of day does not exist on the resulting date")
+		}
+
+		return r, nil
+	}
+
+	return time.Unix(u, int64(t.Nanosecond())).In(t.Location()), nil
+}
+
+func (this *DateTime) cipher(t time.Time, T []byte, enc bool) (
+	time.Time, error) {
+	u := this.units(t)
+	if u < this.min || u > this.max {
+		return time.Time{}, errors.New("time outside of range")
+	}
+
+	lo, hi := this.min, this.max
+
+	if this.keep != PreserveNone {
+		y, m, d := t.Date()
+
+		// determine the start of the period that shares the
+		// preserved components with the input and the start
+		// of the next period
+		var s, e time.Time
+		switch this.keep {
+		case PreserveYear:
+			s = time.Date(y, 1, 1, 0, 0, 0, 0, t.Location())
+			e = s.AddDate(1, 0, 0)
+		case PreserveMonth:
+			s = time.Date(y, m, 1, 0, 0, 0, 0, t.Location())
+			e = s.AddDate(0, 1, 0)
+		case PreserveDay:
+			s = time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+			e = s.AddDate(0, 0, 1)
+		}
+
+		if v := this.units(s); v > lo {
+			lo = v
+		}
+		if v := this.units(e) - 1; v < hi {
+			hi = v
+		}
+
+		if T == nil {
+			T = this.ff1.ctx.twk
+		}
+		T = append(append(make([]byte, 0, len(T)+8), T...),
+			make([]byte, 8)...)
+		binary.BigEndian.PutUint64(T[len(T)-8:], uint64(this.units(s)))
+	}
+
+	// a single possible value can't be hidden
+	if lo == hi {
+		return t, nil
+	}
+
+	n, err := this.ff1.cycleWalk(
+		big.NewInt(u-lo), big.NewInt(hi-lo+1), T, enc)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return this.time(lo+n.Int64(), t)
+}
+
+// Encrypt the time @t with the tweak @T. @t must be within the
+// range specified when the context was created
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *DateTime) Encrypt(t time.Time, T []byte) (time.Time, error) {
+	return this.cipher(t, T, true)
+}
+
+// Decrypt the time @t with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *DateTime) Decrypt(t time.Time, T []byte) (time.Time, error) {
+	return this.cipher(t, T, false)
+}
+
+func (this *DateTime) cipherString(X, layout string, T []byte, enc bool) (
+	string, error) {
+	t, err := time.Parse(layout, X)
+	if err != nil {
+		return "", err
+	}
+
+	t, err = this.cipher(t, T, enc)
+	if err != nil {
+		return "", err
+	}
+
+	return t.Format(layout), nil
+}
+
+// Encrypt the string @X, which is parsed and formatted according
+// to @layout (as used by time.Parse), with the tweak @T. the layout
+// must include all of the components down to the granularity of the
+// context for the result to be decryptable
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *DateTime) EncryptString(X, layout string, T []byte) (
+	string, error) {
+	return this.cipherString(X, layout, T, true)
+}
+
+// Decrypt the string @X, which is parsed and formatted according
+// to @layout (as used by time.Parse), with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *DateTime) DecryptString(X, layout string, T []byte) (
+	string, error) {
+	return this.cipherString(X, layout, T, false)
+}
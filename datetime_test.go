@@ -0,0 +1,200 @@
+package ubiq
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDateTimeDay(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	min := time.Date(1900, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)
+
+	dt, err := NewDateTime(ff1, min, max, GranularityDay, PreserveNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"1900-01-01", "1969-12-31", "1970-01-01", "2000-02-29",
+		"2020-12-31",
+	} {
+		CT, err := dt.EncryptString(PT, "2006-01-02", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ct, err := time.Parse("2006-01-02", CT)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ct.Before(min) || ct.After(max) {
+			t.Fatal(CT + " is out of range")
+		}
+
+		out, err := dt.DecryptString(CT, "2006-01-02", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	if _, err := dt.EncryptString("2021-01-01", "2006-01-02", nil); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestDateTimePreserveYear(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	min := time.Date(1950, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2010, 6, 15, 0, 0, 0, 0, time.UTC)
+
+	dt, err := NewDateTime(ff1, min, max, GranularityDay, PreserveYear)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	loc := time.FixedZone("X", -5*60*60)
+	for _, pt := range []time.Time{
+		time.Date(1950, 1, 1, 10, 30, 0, 0, loc),
+		time.Date(1984, 7, 4, 23, 59, 59, 0, loc),
+		time.Date(2010, 3, 1, 0, 0, 0, 0, loc),
+	} {
+		ct, err := dt.Encrypt(pt, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if ct.Year() != pt.Year() || ct.Location() != loc ||
+			ct.Hour() != pt.Hour() || ct.Minute() != pt.Minute() {
+			t.Fatal(ct)
+		}
+		if ct.Year() == 2010 && ct.After(max) {
+			t.Fatal(ct)
+		}
+
+		out, err := dt.Decrypt(ct, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !out.Equal(pt) {
+			t.Fatal(out, pt)
+		}
+	}
+}
+
+func TestDateTimeSecond(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	min := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	for _, keep := range []Preserve{
+		PreserveNone, PreserveYear, PreserveMonth, PreserveDay,
+	} {
+		dt, err := NewDateTime(ff1, min, max, GranularitySecond, keep)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		PT := "2022-03-15T12:34:56.789Z"
+		CT, err := dt.EncryptString(PT, time.RFC3339Nano, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pt, _ := time.Parse(time.RFC3339Nano, PT)
+		ct, _ := time.Parse(time.RFC3339Nano, CT)
+		if ct.Nanosecond() != pt.Nanosecond() ||
+			(keep >= PreserveYear && ct.Year() != pt.Year()) ||
+			(keep >= PreserveMonth && ct.Month() != pt.Month()) ||
+			(keep >= PreserveDay && ct.Day() != pt.Day()) {
+			t.Fatal(CT)
+		}
+
+		out, err := dt.DecryptString(CT, time.RFC3339Nano, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestDateTimeInvalid(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	min := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	max := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	if _, err := NewDateTime(ff1, max, min,
+		GranularityDay, PreserveNone); err == nil {
+		t.FailNow()
+	}
+	if _, err := NewDateTime(ff1, min, max,
+		GranularityDay, PreserveDay); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestDateTimeDaylightSaving(t *testing.T) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	min := time.Date(2000, 1, 1, 0, 0, 0, 0, loc)
+	max := time.Date(2020, 12, 31, 0, 0, 0, 0, loc)
+
+	dt, err := NewDateTime(ff1, min, max, GranularityDay, PreserveNone)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the time elapsed since the start of the day of @t
+	elapsed := func(t time.Time) time.Duration {
+		y, m, d := t.Date()
+		return t.Sub(time.Date(y, m, d, 0, 0, 0, 0, loc))
+	}
+
+	// times within the hours that are skipped or repeated when
+	// the clocks are changed, as well as late in the day, over a
+	// year. among them, 2:30 am on the day before the clocks go
+	// forward is encrypted to the day on which they do
+	for d := 0; d < 365; d++ {
+		for _, c := range [][2]int{{1, 30}, {2, 30}, {23, 30}} {
+			PT := time.Date(2010, 1, 1+d, c[0], c[1], 0, 0, loc)
+
+			CT, err := dt.Encrypt(PT, nil)
+			if err != nil {
+				// only possible late in the day
+				if c[0] < 23 {
+					t.Fatal(PT, err)
+				}
+				continue
+			}
+
+			if elapsed(CT) != elapsed(PT) {
+				t.Fatal(PT, CT)
+			}
+
+			out, err := dt.Decrypt(CT, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !out.Equal(PT) {
+				t.Fatal(PT, CT, out)
+			}
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package ubiq
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// Context structure for encrypting decimal numbers with a fixed
+// number of fractional digits such that the result is a number
+// with the same number of fractional digits within a given range
+type Decimal struct {
+	ff1 *FF1
+
+	scale int
+	// whether the integer digits are grouped with commas
+	grouped bool
+	// the bounds of the range, inclusive, as
+	// integers scaled by 10**scale
+	min, max *big.Int
+}
+
+// Allocate a new decimal context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// @scale is the number of digits following the decimal point, and
+// @min and @max are the smallest and largest values (inclusive) that
+// may be input or output. the bounds may have up to @scale digits
+// following the decimal point
+//
+// if @grouped is true, the integer digits of outputs are always
+// grouped with commas, and inputs may be grouped or not; otherwise,
+// outputs are never grouped, and inputs may not contain commas
+func NewDecimal(ff1 *FF1, scale int, min, max string, grouped bool) (
+	*Decimal, error) {
+	if scale < 0 {
+		return nil, errors.New("invalid scale")
+	}
+
+	this := &Decimal{ff1: ff1, scale: scale, grouped: grouped}
+
+	var err error
+	if this.min, err = this.parse(min, false); err != nil {
+		return nil, err
+	} else if this.max, err = this.parse(max, false); err != nil {
+		return nil, err
+	} else if this.min.Cmp(this.max) >= 0 {
+		return nil, errors.New("invalid range")
+	}
+
+	return this, nil
+}
+
+// parse the decimal number @X into an integer scaled by 10**scale.
+// the number may begin with a + or - and, if grouping is enabled,
+// its integer digits may be grouped with commas. if @exact is true,
+// the number must have exactly @scale digits following the decimal
+// point; otherwise, it may have fewer
+func (this *Decimal) parse(X string, exact bool) (*big.Int, error) {
+	var neg bool
+
+	if len(X) > 0 && (X[0] == '-' || X[0] == '+') {
+		neg = X[0] == '-'
+		X = X[1:]
+	}
+
+	I, F, dot := strings.Cut(X, ".")
+
+	if this.grouped && strings.ContainsRune(I, ',') {
+		// all groups but the first must have 3 digits
+		g := strings.Split(I, ",")
+		for i := range g {
+			if len(g[i]) < 1 || len(g[i]) > 3 ||
+				(i > 0 && len(g[i]) != 3) {
+				return nil, errors.New("invalid digit grouping")
+			}
+		}
+
+		I = strings.Join(g, "")
+	}
+
+	if len(I) == 0 || (dot && this.scale == 0) ||
+		(dot && len(F) == 0) || len(F) > this.scale ||
+		(exact && len(F) != this.scale) {
+		return nil, errors.New("invalid number format")
+	}
+
+	D := I + F + strings.Repeat("0", this.scale-len(F))
+	for _, c := range D {
+		if c < '0' || c > '9' {
+			return nil, errors.New("invalid character in input")
+		}
+	}
+
+	n, _ := new(big.Int).SetString(D, 10)
+	if neg {
+		n.Neg(n)
+	}
+
+	return n, nil
+}
+
+// format the scaled integer @n as a decimal number, grouping
+// the integer digits with commas if grouping is enabled
+func (this *Decimal) format(n *big.Int) string {
+	D := new(big.Int).Abs(n).Text(10)
+	if len(D) <= this.scale {
+		D = strings.Repeat("0", this.scale-len(D)+1) + D
+	}
+
+	I, F := D[:len(D)-this.scale], D[len(D)-this.scale:]
+
+	if this.grouped {
+		var b strings.Builder
+		for i := range I {
+			if i > 0 && (len(I)-i)%3 == 0 {
+				b.WriteByte(',')
+			}
+			b.WriteByte(I[i])
+		}
+		I = b.String()
+	}
+
+	s := I
+	if this.scale > 0 {
+		s += "." + F
+	}
+	if n.Sign() < 0 {
+		s = "-" + s
+	}
+
+	return s
+}
+
+func (this *Decimal) cipher(X string, T []byte, enc bool) (string, error) {
+	n, err := this.parse(X, true)
+	if err != nil {
+		return "", err
+	} else if n.Cmp(this.min) < 0 || n.Cmp(this.max) > 0 {
+		return "", errors.New("value outside of range")
+	}
+
+	m := new(big.Int).Sub(this.max, this.min)
+	m.Add(m, big.NewInt(1))
+
+	n, err = this.ff1.cycleWalk(n.Sub(n, this.min), m, T, enc)
+	if err != nil {
+		return "", err
+	}
+
+	return this.format(n.Add(n, this.min)), nil
+}
+
+// Encrypt a decimal number @X with the tweak @T. the number must
+// have exactly the configured number of fractional digits and be
+// within the configured range. the output has the same number of
+// fractional digits as the input and is grouped with commas if and
+// only if grouping is enabled, regardless of the grouping of the
+// input. a leading + is not reproduced
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Decimal) Encrypt(X string, T []byte) (string, error) {
+	return this.cipher(X, T, true)
+}
+
+// Decrypt a decimal number @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Decimal) Decrypt(X string, T []byte) (string, error) {
+	return this.cipher(X, T, false)
+}
@@ -0,0 +1,124 @@
+package ubiq
+
+import (
+	"math/big"
+	"testing"
+)
+
+func testDecimal(t *testing.T, grouped bool, inputs ...string) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dec, err := NewDecimal(ff1, 2, "-10000", "1000000.5", grouped)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range inputs {
+		CT, err := dec.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		n, err := dec.parse(CT, true)
+		if err != nil {
+			t.Fatal(CT, err)
+		}
+		if n.Cmp(dec.min) < 0 || n.Cmp(dec.max) > 0 {
+			t.Fatal(CT + " is out of range")
+		}
+		if CT != dec.format(n) {
+			t.Fatal(CT + " is not formatted consistently")
+		}
+
+		out, err := dec.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		m, _ := dec.parse(out, true)
+		p, _ := dec.parse(PT, true)
+		if m.Cmp(p) != 0 || out != dec.format(p) {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestDecimal(t *testing.T) {
+	testDecimal(t, false,
+		"12345.67", "-9999.99", "0.00", "-10000.00", "1000000.50",
+		"123456.00", "999999.99", "0.01")
+}
+
+func TestDecimalGrouped(t *testing.T) {
+	testDecimal(t, true,
+		"12345.67", "-9999.99", "0.00", "-10000.00", "1,000,000.50",
+		"123,456.00", "999999.99", "0.01", "1,000.01")
+
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	dec, _ := NewDecimal(ff1, 2, "0", "10000", true)
+
+	// a ciphertext too small to contain a comma
+	// still decrypts to a grouped value
+	CT, err := dec.Encrypt("1,000.01", nil)
+	if err != nil {
+		t.Fatal(err)
+	} else if CT != "600.96" {
+		t.Fatal(CT)
+	}
+
+	if out, err := dec.Decrypt(CT, nil); err != nil {
+		t.Fatal(err)
+	} else if out != "1,000.01" {
+		t.Fatal(out)
+	}
+}
+
+func TestDecimalFormat(t *testing.T) {
+	dec := &Decimal{scale: 2, grouped: true}
+
+	for s, n := range map[string]int64{
+		"0.05": 5, "-0.50": -50, "1,234.56": 123456, "-123,456.00": -12345600,
+	} {
+		if out := dec.format(big.NewInt(n)); out != s {
+			t.Fatal(out + " != " + s)
+		}
+	}
+
+	dec = &Decimal{scale: 0}
+	if out := dec.format(big.NewInt(-7)); out != "-7" {
+		t.Fatal(out)
+	}
+}
+
+func TestDecimalInvalid(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	dec, err := NewDecimal(ff1, 2, "0", "1000", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"12.5", "12.345", "1000.01", "-0.01", "12,34.00", "1e3.00", ".50",
+	} {
+		if _, err := dec.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+
+	// commas are not accepted unless grouping is enabled
+	dec, _ = NewDecimal(ff1, 2, "0", "10000", false)
+	if _, err := dec.Encrypt("1,000.00", nil); err == nil {
+		t.FailNow()
+	}
+
+	if _, err := NewDecimal(ff1, 2, "5", "5", false); err == nil {
+		t.FailNow()
+	}
+	if _, err := NewDecimal(ff1, 0, "0", "1.5", false); err == nil {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,156 @@
+package ubiq
+
+import (
+	"encoding/binary"
+	"errors"
+)
+
+// Context structure for encrypting email addresses such
+// that the result is still a well-formed email address
+type Email struct {
+	cp *ClassPreserving
+
+	domain bool
+}
+
+// Allocate a new email context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used, and, as with
+// the ClassPreserving context, it should allow tweaks of arbitrary
+// length
+//
+// the local part of the address (preceding the @) is encrypted such
+// that each character retains its class, while dots, plus signs and
+// other punctuation are passed through. if @domain is true, the
+// labels of the domain, except for the top-level domain, are also
+// encrypted in the same way; otherwise, the domain is left as is.
+// each label is encrypted independently of the local part and of
+// the labels to its left, so that addresses sharing a domain still
+// share it after encryption, as do subdomains of the same domain
+func NewEmail(ff1 *FF1, domain bool) (*Email, error) {
+	cp, err := NewClassPreserving(ff1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Email{cp: cp, domain: domain}, nil
+}
+
+func (this *Email) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	at := -1
+	for i, r := range X {
+		if r == '@' {
+			if at >= 0 {
+				return nil, errors.New("invalid email address")
+			}
+			at = i
+		}
+	}
+
+	if at < 1 || at == len(X)-1 {
+		return nil, errors.New("invalid email address")
+	}
+
+	if T == nil {
+		T = this.cp.ff1.ctx.twk
+	}
+	// separate tweaks for the local part and the domain
+	twk := make([]byte, len(T)+1)
+	copy(twk, T)
+
+	Y := make([]rune, len(X))
+	copy(Y, X)
+
+	twk[len(T)] = 'L'
+	L, err := this.cp.cipher(X[:at], twk, enc)
+	if err != nil {
+		return nil, err
+	}
+	copy(Y, L)
+
+	if this.domain {
+		// find the start of the top-level domain
+		end := len(X)
+		for i := len(X) - 1; i > at; i-- {
+			if X[i] == '.' {
+				end = i
+				break
+			}
+		}
+
+		// the labels preceding the top-level domain are encrypted
+		// from right to left, each with a tweak containing the
+		// (plaintext) domain to its right, so that subdomains of
+		// a domain share its encryption
+		for end < len(X) && end > at {
+			i := end - 1
+			for i > at && X[i] != '.' {
+				i--
+			}
+
+			P := X[end:]
+			if !enc {
+				P = Y[end:]
+			}
+
+			D, err := this.cp.cipher(X[i+1:end],
+				domainTweak(T, 'D', string(P)), enc)
+			if err != nil {
+				return nil, err
+			}
+			copy(Y[i+1:], D)
+
+			end = i
+		}
+	}
+
+	return Y, nil
+}
+
+// build the tweak for a label of a domain from the caller's tweak
+// @T, a byte @c identifying the context in which the domain is used,
+// and the parent domain @P of the label, which includes its leading
+// dot. this is used by both the Email and URL contexts
+func domainTweak(T []byte, c byte, P string) []byte {
+	twk := append(make([]byte, 0, len(T)+1+binary.MaxVarintLen64+len(P)),
+		T...)
+	twk = append(twk, c)
+
+	buf := make([]byte, binary.MaxVarintLen64)
+	twk = append(twk, buf[:binary.PutUvarint(buf, uint64(len(P)))]...)
+
+	return append(twk, P...)
+}
+
+func (this *Email) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt an email address @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Email) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *Email) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt an email address @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Email) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
@@ -0,0 +1,98 @@
+package ubiq
+
+import (
+	"net/mail"
+	"strings"
+	"testing"
+)
+
+func testEmail(t *testing.T, domain bool, inputs ...string) []string {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	email, err := NewEmail(ff1, domain)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var res []string
+	for _, PT := range inputs {
+		CT, err := email.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if _, err := mail.ParseAddress(CT); err != nil {
+			t.Fatal(CT, err)
+		}
+
+		pl, pd, _ := strings.Cut(PT, "@")
+		cl, cd, _ := strings.Cut(CT, "@")
+		if cl == pl || len(cl) != len(pl) ||
+			strings.Count(cl, ".") != strings.Count(pl, ".") ||
+			strings.Index(cl, "+") != strings.Index(pl, "+") {
+			t.Fatal(CT)
+		}
+		if !domain && cd != pd {
+			t.Fatal(CT)
+		} else if pd[strings.LastIndex(pd, "."):] !=
+			cd[strings.LastIndex(cd, "."):] {
+			t.Fatal(CT)
+		}
+
+		out, err := email.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+
+		res = append(res, CT)
+	}
+
+	return res
+}
+
+func TestEmail(t *testing.T) {
+	testEmail(t, false,
+		"john.smith@example.com",
+		"Jane.Doe+newsletter@mail.example.co.uk",
+		"user123@example.org")
+}
+
+func TestEmailDomain(t *testing.T) {
+	res := testEmail(t, true,
+		"john.smith@example.com",
+		"jane.doe+tag@example.com",
+		"ops-team@mail-01.corp.example.net",
+		"admin@mail.example.com")
+
+	// addresses sharing a domain still share one
+	_, a, _ := strings.Cut(res[0], "@")
+	_, b, _ := strings.Cut(res[1], "@")
+	if a != b || a == "example.com" {
+		t.Fatal(a, b)
+	}
+
+	// as do subdomains of that domain
+	_, c, _ := strings.Cut(res[3], "@")
+	if !strings.HasSuffix(c, "."+a) || strings.HasPrefix(c, "mail.") {
+		t.Fatal(a, c)
+	}
+}
+
+func TestEmailInvalid(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	email, _ := NewEmail(ff1, true)
+
+	for _, PT := range []string{
+		"john.smith", "@example.com", "john@", "a@b@example.com",
+	} {
+		if _, err := email.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
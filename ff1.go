@@ -5,6 +5,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"math"
+	"math/big"
 )
 
 // Context structure for FF1 FPE algorithm
@@ -26,8 +27,16 @@ type FF1 struct {
 //
 // @radix specifies the radix of the input/output data
 //
-// the function also accepts an optional argument:
-// @alpha is a string containing the alphabet for numerical conversions
+// the function also accepts optional arguments:
+// @alpha is a string containing the alphabet for numerical conversions,
+// or an Alphabet (or a pointer to one) such as DigitsAlphabet
+// the first @radix characters of @alpha must not contain duplicates;
+// if they do, the context is not created
+// @fp is a string containing the expected fingerprint (as returned by
+// Alphabet.Fingerprint) of the first @radix characters of @alpha. if
+// the fingerprint does not match, the context is not created
+// @norm is a Normalization to be applied to inputs prior to encryption
+// and decryption. it may appear anywhere among the optional arguments
 func NewFF1(key, twk []byte, mintwk, maxtwk, radix int, args ...interface{}) (
 	*FF1, error) {
 	var err error
@@ -40,19 +49,82 @@ func NewFF1(key, twk []byte, mintwk, maxtwk, radix int, args ...interface{}) (
 	return this, err
 }
 
+// Clone returns a copy of the context that shares its expanded
+// key, alphabet, and default tweak. a single context must not be
+// used concurrently, but clones may be used by different goroutines
+func (this *FF1) Clone() *FF1 {
+	return &FF1{ctx: this.ctx.clone()}
+}
+
+// WithTweak returns a copy of the context, sharing its expanded
+// key and alphabet, with the default tweak @twk, which may be nil
+// and must be within the tweak length limits of this context
+func (this *FF1) WithTweak(twk []byte) (*FF1, error) {
+	ctx, err := this.ctx.withTweak(twk)
+	if err != nil {
+		return nil, err
+	}
+	return &FF1{ctx: ctx}, nil
+}
+
+// WithAlphabet returns a new context, sharing the expanded key,
+// default tweak, and tweak length limits of this one, with the radix
+// @radix. the optional arguments are the same as those accepted by
+// NewFF1; as there, if no alphabet is specified, the default
+// alphabet is used
+func (this *FF1) WithAlphabet(radix int, args ...interface{}) (
+	*FF1, error) {
+	ctx := this.ctx
+
+	c, err := newFFXBlock(ctx.block, ctx.twk, ctx.len.txt.max,
+		ctx.len.twk.min, ctx.len.twk.max, radix, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &FF1{ctx: c}, nil
+}
+
 // encryption and decryption are largely the same and are implemented
 // in this single function with differences handled depending on the
 // value of the @enc parameter. @X is the input, @T is the tweak,
 // and the result is returned
+func (this *FF1) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	ctx := this.ctx
+
+	X, err := ctx.prepare(X)
+	if err != nil {
+		return nil, err
+	}
+
+	n := len(X)
+	u := n / 2
+	v := n - u
+
+	RunesToBigInt(ctx.nA, &ctx.alpha, X[:u])
+	RunesToBigInt(ctx.nB, &ctx.alpha, X[u:])
+
+	err = this.cipherInts(ctx.alpha.Len(), n, ctx.len.txt.min, T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(
+			BigIntToRunes(&ctx.alpha, ctx.nA, u),
+			BigIntToRunes(&ctx.alpha, ctx.nB, v)...),
+		nil
+}
+
+// the numerical core of the algorithm. the input is taken from, and
+// the output is placed in, the nA and nB members of the context as
+// the integer representations of the first (n / 2) and remaining
+// numerals of a string of @n numerals in the given @radix. @mintxt
+// is the minimum number of numerals allowed for that radix
 //
 // The comments below reference the steps of the algorithm described here:
 // https://nvlpubs.nist.gov/nistpubs/SpecialPublications/NIST.SP.800-38Gr1-draft.pdf
-func (this *FF1) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
-	var radix int = this.ctx.alpha.Len()
-
+func (this *FF1) cipherInts(radix, n, mintxt int, T []byte, enc bool) error {
 	ctx := this.ctx
 
-	n := len(X)
 	u := n / 2
 	v := n - u
 
@@ -65,6 +137,15 @@ func (this *FF1) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
 		T = ctx.twk
 	}
 
+	if n < mintxt ||
+		n > ctx.len.txt.max {
+		return errors.New("invalid text length")
+	} else if len(T) < ctx.len.twk.min ||
+		(ctx.len.twk.max > 0 &&
+			len(T) > ctx.len.twk.max) {
+		return errors.New("invalid tweak length")
+	}
+
 	// P and Q are independently filled-in/populated, but
 	// Q is appended to P for the purposes of en/decrypting
 	// data. Therefore P is made large enough to accommodate
@@ -74,15 +155,6 @@ func (this *FF1) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
 	Q := P[16:]
 	R := make([]byte, ((d+15)/16)*16)
 
-	if n < ctx.len.txt.min ||
-		n > ctx.len.txt.max {
-		return nil, errors.New("invalid text length")
-	} else if len(T) < ctx.len.twk.min ||
-		(ctx.len.twk.max > 0 &&
-			len(T) > ctx.len.twk.max) {
-		return nil, errors.New("invalid tweak length")
-	}
-
 	P[0] = 1
 	P[1] = 2
 	// note that this overwrites index 2, but we aren't interested
@@ -108,8 +180,6 @@ func (this *FF1) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
 		ctx.mV.Mul(ctx.mV, ctx.y)
 	}
 
-	RunesToBigInt(ctx.nA, &ctx.alpha, X[:u])
-	RunesToBigInt(ctx.nB, &ctx.alpha, X[u:])
 	if !enc {
 		ctx.nA, ctx.nB = ctx.nB, ctx.nA
 		ctx.mU, ctx.mV = ctx.mV, ctx.mU
@@ -159,10 +229,7 @@ func (this *FF1) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
 		ctx.nA, ctx.nB = ctx.nB, ctx.nA
 	}
 
-	return append(
-			BigIntToRunes(&ctx.alpha, ctx.nA, u),
-			BigIntToRunes(&ctx.alpha, ctx.nB, v)...),
-		nil
+	return nil
 }
 
 func (this *FF1) EncryptRunes(X []rune, T []byte) ([]rune, error) {
@@ -194,3 +261,108 @@ func (this *FF1) Decrypt(X string, T []byte) (Y string, err error) {
 	}
 	return Y, err
 }
+
+func (this *FF1) cipherBytes(X, T []byte, enc bool) ([]byte, error) {
+	ctx := this.ctx
+
+	n := len(X)
+	u := n / 2
+
+	// a string of radix 256 numerals is simply the big-endian
+	// representation of the corresponding integer
+	ctx.nA.SetBytes(X[:u])
+	ctx.nB.SetBytes(X[u:])
+
+	err := this.cipherInts(256, n, minTextLen(256), T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	Y := make([]byte, n)
+	ctx.nA.FillBytes(Y[:u])
+	ctx.nB.FillBytes(Y[u:])
+
+	return Y, nil
+}
+
+// Encrypt the bytes @X, treated as a string of radix 256 numerals,
+// with the tweak @T. The output is the same length as the input.
+//
+// The radix and alphabet of the context are not used. The result
+// is the same as that produced by a context with a radix of 256
+// where the alphabet consists of the byte values, in order
+//
+// @T may be nil, in which case the default tweak will be used
+func (this *FF1) EncryptBytes(X, T []byte) ([]byte, error) {
+	return this.cipherBytes(X, T, true)
+}
+
+// Decrypt the bytes @X, treated as a string of radix 256 numerals,
+// with the tweak @T
+//
+// @T may be nil, in which case the default tweak will be used
+func (this *FF1) DecryptBytes(X, T []byte) ([]byte, error) {
+	return this.cipherBytes(X, T, false)
+}
+
+func (this *FF1) cipherBits(X []byte, n int, T []byte, enc bool) (
+	[]byte, error) {
+	ctx := this.ctx
+
+	if n < 0 || (n+7)/8 != len(X) {
+		return nil, errors.New("invalid bit length")
+	}
+
+	u := n / 2
+	v := n - u
+	// unused bits at the end of the last byte
+	p := uint(8*len(X) - n)
+
+	y := new(big.Int).SetBytes(X)
+	y.Rsh(y, p)
+
+	mask := bitMask(uint(v))
+
+	ctx.nA.Rsh(y, uint(v))
+	ctx.nB.And(y, mask)
+
+	err := this.cipherInts(2, n, minTextLen(2), T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	y.Lsh(ctx.nA, uint(v))
+	y.Or(y, ctx.nB)
+	y.Lsh(y, p)
+
+	Y := make([]byte, len(X))
+	y.FillBytes(Y)
+	// carry the unused bits over from the input
+	Y[len(Y)-1] |= X[len(X)-1] & byte(1<<p-1)
+
+	return Y, nil
+}
+
+// Encrypt the first @n bits of @X, treated as a string of radix 2
+// numerals with the most significant bit of each byte first, with
+// the tweak @T. @X must be exactly long enough to hold @n bits; any
+// unused bits at the end of the last byte are copied to the output
+// unchanged.
+//
+// The radix and alphabet of the context are not used. The result
+// is the same as that produced by a context with a radix of 2 for
+// the equivalent string of "0" and "1" characters
+//
+// @T may be nil, in which case the default tweak will be used
+func (this *FF1) EncryptBits(X []byte, n int, T []byte) ([]byte, error) {
+	return this.cipherBits(X, n, T, true)
+}
+
+// Decrypt the first @n bits of @X, treated as a string of radix 2
+// numerals with the most significant bit of each byte first, with
+// the tweak @T
+//
+// @T may be nil, in which case the default tweak will be used
+func (this *FF1) DecryptBits(X []byte, n int, T []byte) ([]byte, error) {
+	return this.cipherBits(X, n, T, false)
+}
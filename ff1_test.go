@@ -1,6 +1,7 @@
 package ubiq
 
 import (
+	"sync"
 	"testing"
 )
 
@@ -316,6 +317,195 @@ func TestFF1UTF8(t *testing.T) {
 		len([]rune(alphabet)), alphabet)
 }
 
+func TestFF1Bytes(t *testing.T) {
+	K := []byte{
+		0x2b, 0x7e, 0x15, 0x16, 0x28, 0xae, 0xd2, 0xa6,
+		0xab, 0xf7, 0x15, 0x88, 0x09, 0xcf, 0x4f, 0x3c,
+	}
+	T := []byte{0x39, 0x38, 0x37, 0x36, 0x35, 0x34, 0x33, 0x32}
+	PT := []byte{
+		0x00, 0x01, 0x7f, 0x80, 0xfe, 0xff, 0x12, 0x34,
+		0x56, 0x78, 0x9a, 0xbc, 0xde, 0xf0, 0x00, 0x00,
+		0xaa, 0x55, 0x01, 0x02,
+	}
+
+	// an alphabet of 256 characters in which the
+	// position of each character is its byte value
+	alpha := make([]rune, 256)
+	for i := range alpha {
+		alpha[i] = rune(0x100 + i)
+	}
+
+	ff1, err := NewFF1(K, T, 0, 0, 256, string(alpha))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	X := make([]rune, len(PT))
+	for i, b := range PT {
+		X[i] = alpha[b]
+	}
+
+	Y, err := ff1.EncryptRunes(X, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	CT, err := ff1.EncryptBytes(PT, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(CT) != len(PT) {
+		t.FailNow()
+	}
+	for i := range CT {
+		if rune(0x100+int(CT[i])) != Y[i] {
+			t.FailNow()
+		}
+	}
+
+	out, err := ff1.DecryptBytes(CT, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(PT) {
+		t.FailNow()
+	}
+
+	if _, err := ff1.EncryptBytes(PT[:2], nil); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestFF1Bits(t *testing.T) {
+	K := []byte{
+		0xf4, 0xa1, 0x16, 0xd6, 0xee, 0x40, 0x6a, 0x53,
+		0xa5, 0x6c, 0xbe, 0x0f, 0x4a, 0xa7, 0xb1, 0x00,
+		0x1c, 0xdc, 0x0a, 0x55, 0xca, 0xc9, 0x63, 0xcf,
+		0x5a, 0xce, 0x39, 0x04, 0x88, 0xb3, 0x47, 0x7a,
+	}
+	T := []byte{
+		0xfd, 0x7f, 0x4b, 0x99, 0x45, 0xa3, 0xc5, 0x35,
+		0xad, 0xb4, 0x72, 0x00, 0x27, 0x11, 0x6c, 0xa0,
+		0xf4, 0x98, 0x7d, 0x7f, 0x3f, 0xdb, 0xa9, 0xbb,
+		0xc4, 0x0e, 0x75, 0x37, 0x5f, 0xea, 0xa6, 0x3c,
+	}
+	PT := "00000101011011011101001001010011100111100011001"
+	CT := "10110101001110101101110000011000000011111100111"
+
+	pack := func(s string) []byte {
+		b := make([]byte, (len(s)+7)/8)
+		for i, c := range s {
+			if c == '1' {
+				b[i/8] |= 0x80 >> (i % 8)
+			}
+		}
+		// set the unused bit, which must pass through
+		b[len(b)-1] |= 1
+		return b
+	}
+
+	ff1, err := NewFF1(K, T, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := ff1.EncryptBits(pack(PT), len(PT), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(pack(CT)) {
+		t.FailNow()
+	}
+
+	out, err = ff1.DecryptBits(out, len(CT), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(out) != string(pack(PT)) {
+		t.FailNow()
+	}
+
+	if _, err := ff1.EncryptBits(pack(PT), len(PT)+8, nil); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestFF1Derived(t *testing.T) {
+	K := []byte{
+		0x2b, 0x7e, 0x15, 0x16, 0x28, 0xae, 0xd2, 0xa6,
+		0xab, 0xf7, 0x15, 0x88, 0x09, 0xcf, 0x4f, 0x3c,
+	}
+
+	ff1, err := NewFF1(K, nil, 0, 16, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// NIST sample 2
+	twk, err := ff1.WithTweak([]byte{
+		0x39, 0x38, 0x37, 0x36, 0x35, 0x34, 0x33, 0x32,
+		0x31, 0x30,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if CT, _ := twk.Encrypt("0123456789", nil); CT != "6124200773" {
+		t.Fatal(CT)
+	}
+
+	// NIST sample 3
+	twk, err = ff1.WithTweak([]byte{
+		0x37, 0x37, 0x37, 0x37, 0x70, 0x71, 0x72, 0x73,
+		0x37, 0x37, 0x37,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alpha, err := twk.WithAlphabet(36)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if CT, _ := alpha.Encrypt("0123456789abcdefghi", nil); CT !=
+		"a9tv40mll9kdu509eum" {
+		t.Fatal(CT)
+	}
+
+	// the original context is unaffected
+	if CT, _ := ff1.Encrypt("0123456789", nil); CT != "2433477484" {
+		t.Fatal(CT)
+	}
+
+	if twk.ctx.block != ff1.ctx.block ||
+		alpha.ctx.block != ff1.ctx.block {
+		t.Fatal("key schedule not shared")
+	}
+
+	if _, err := ff1.WithTweak(make([]byte, 17)); err == nil {
+		t.Fatal("tweak length limit not enforced")
+	}
+	if _, err := ff1.WithAlphabet(11, DigitsAlphabet); err == nil {
+		t.Fatal("radix larger than alphabet accepted")
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func(c *FF1) {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				if CT, _ := c.Encrypt("0123456789", nil); CT !=
+					"2433477484" {
+					t.Error(CT)
+					return
+				}
+			}
+		}(ff1.Clone())
+	}
+	wg.Wait()
+}
+
 func benchmarkFF1(
 	b *testing.B, f func(*FF1, string, []byte) (string, error),
 	K, T []byte, INP, OUT string, r int, args ...interface{}) {
@@ -20,8 +20,16 @@ type FF3_1 struct {
 //
 // @radix specifies the radix of the input/output data
 //
-// the function also accepts an optional argument:
-// @alpha is a string containing the alphabet for numerical conversions
+// the function also accepts optional arguments:
+// @alpha is a string containing the alphabet for numerical conversions,
+// or an Alphabet (or a pointer to one) such as DigitsAlphabet
+// the first @radix characters of @alpha must not contain duplicates;
+// if they do, the context is not created
+// @fp is a string containing the expected fingerprint (as returned by
+// Alphabet.Fingerprint) of the first @radix characters of @alpha. if
+// the fingerprint does not match, the context is not created
+// @norm is a Normalization to be applied to inputs prior to encryption
+// and decryption. it may appear anywhere among the optional arguments
 func NewFF3_1(key, twk []byte, radix int, args ...interface{}) (*FF3_1, error) {
 	var err error
 
@@ -30,23 +38,59 @@ func NewFF3_1(key, twk []byte, radix int, args ...interface{}) (*FF3_1, error) {
 	revb(K, key)
 
 	this := new(FF3_1)
-	this.ctx, err = newFFX(K, twk,
-		// maxlen for ff3-1:
-		// = 2 * log_radix(2**96)
-		// = 2 * log_radix(2**48 * 2**48)
-		// = 2 * (log_radix(2**48) + log_radix(2**48))
-		// = 2 * (2 * log_radix(2**48))
-		// = 4 * log_radix(2**48)
-		// = 4 * log2(2**48) / log2(radix)
-		// = 4 * 48 / log2(radix)
-		// = 192 / log2(radix)
-		int(float64(192)/math.Log2(float64(radix))),
-		7, 7,
+	this.ctx, err = newFFX(K, twk, maxTextLenFF3_1(radix), 7, 7,
 		radix, args...)
 
 	return this, err
 }
 
+// determine the maximum number of numerals allowed
+// in a {plain,cipher}text of the given @radix
+func maxTextLenFF3_1(radix int) int {
+	// maxlen for ff3-1:
+	// = 2 * log_radix(2**96)
+	// = 2 * log_radix(2**48 * 2**48)
+	// = 2 * (log_radix(2**48) + log_radix(2**48))
+	// = 2 * (2 * log_radix(2**48))
+	// = 4 * log_radix(2**48)
+	// = 4 * log2(2**48) / log2(radix)
+	// = 4 * 48 / log2(radix)
+	// = 192 / log2(radix)
+	return int(float64(192) / math.Log2(float64(radix)))
+}
+
+// Clone returns a copy of the context that shares its expanded
+// key, alphabet, and default tweak. a single context must not be
+// used concurrently, but clones may be used by different goroutines
+func (this *FF3_1) Clone() *FF3_1 {
+	return &FF3_1{ctx: this.ctx.clone()}
+}
+
+// WithTweak returns a copy of the context, sharing its expanded
+// key and alphabet, with the default tweak @twk, which must be
+// exactly 7 bytes long
+func (this *FF3_1) WithTweak(twk []byte) (*FF3_1, error) {
+	ctx, err := this.ctx.withTweak(twk)
+	if err != nil {
+		return nil, err
+	}
+	return &FF3_1{ctx: ctx}, nil
+}
+
+// WithAlphabet returns a new context, sharing the expanded key and
+// default tweak of this one, with the radix @radix. the optional
+// arguments are the same as those accepted by NewFF3_1; as there,
+// if no alphabet is specified, the default alphabet is used
+func (this *FF3_1) WithAlphabet(radix int, args ...interface{}) (
+	*FF3_1, error) {
+	ctx, err := newFFXBlock(this.ctx.block, this.ctx.twk,
+		maxTextLenFF3_1(radix), 7, 7, radix, args...)
+	if err != nil {
+		return nil, err
+	}
+	return &FF3_1{ctx: ctx}, nil
+}
+
 // encryption and decryption are largely the same and are implemented
 // in this single function with differences handled depending on the
 // value of the @enc parameter. @X is the input, @T is the tweak,
@@ -57,6 +101,11 @@ func NewFF3_1(key, twk []byte, radix int, args ...interface{}) (*FF3_1, error) {
 func (this *FF3_1) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
 	ctx := this.ctx
 
+	X, err := ctx.prepare(X)
+	if err != nil {
+		return nil, err
+	}
+
 	n := len(X)
 	v := n / 2
 	u := n - v
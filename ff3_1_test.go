@@ -434,3 +434,49 @@ func BenchmarkFF3_1Decrypt256(b *testing.B) {
 		"890121234567890000",
 		10)
 }
+
+func TestFF3_1Derived(t *testing.T) {
+	ff3_1, err := NewFF3_1(
+		[]byte{
+			0xad, 0x41, 0xec, 0x5d, 0x23, 0x56, 0xde, 0xae,
+			0x53, 0xae, 0x76, 0xf5, 0x0b, 0x4b, 0xa6, 0xd2,
+		},
+		make([]byte, 7), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ACVP sample 1
+	twk, err := ff3_1.WithTweak([]byte{
+		0xcf, 0x29, 0xda, 0x1e, 0x18, 0xd9, 0x70,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if CT, _ := twk.Encrypt("6520935496", nil); CT != "4716569208" {
+		t.Fatal(CT)
+	}
+	if twk.ctx.block != ff3_1.ctx.block {
+		t.Fatal("key schedule not shared")
+	}
+
+	if _, err := ff3_1.WithTweak(make([]byte, 8)); err == nil {
+		t.Fatal("invalid tweak length accepted")
+	}
+
+	alpha, err := twk.WithAlphabet(16, HexLowerAlphabet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if alpha.ctx.len.txt.max != maxTextLenFF3_1(16) {
+		t.Fatal(alpha.ctx.len.txt.max)
+	}
+
+	CT, err := alpha.Encrypt("0123456789abcdef", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if PT, _ := alpha.Clone().Decrypt(CT, nil); PT != "0123456789abcdef" {
+		t.Fatal(PT)
+	}
+}
@@ -12,10 +12,16 @@ var cipherIV = [...]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0}
 
 // common structure used by fpe algorithms
 type ffx struct {
-	// aes-cbc 128, 192, or 256. depends on key size
+	// aes 128, 192, or 256. depends on key size. the expanded
+	// key is shared by contexts derived from this one
+	block cipher.Block
+	// aes-cbc using the block above; this is not shared
 	blockMode cipher.BlockMode
 
 	alpha Alphabet
+	// transformations applied to inputs
+	// before they are encrypted or decrypted
+	norm Normalization
 
 	// minimum and maximum lengths allowed for
 	// {plain,cipher}text and tweaks
@@ -38,9 +44,41 @@ type ffx struct {
 func newFFX(key, twk []byte,
 	maxtxt, mintwk, maxtwk, radix int,
 	args ...interface{}) (*ffx, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return newFFXBlock(block, twk, maxtxt, mintwk, maxtwk, radix, args...)
+}
+
+// allocate a new FFX context using an existing (expanded) key
+func newFFXBlock(block cipher.Block, twk []byte,
+	maxtxt, mintwk, maxtwk, radix int,
+	args ...interface{}) (*ffx, error) {
+	// a normalization may appear anywhere among the arguments;
+	// the remaining ones are the alphabet and its fingerprint
+	var nrm Normalization
+	for i := 0; i < len(args); i++ {
+		if v, ok := args[i].(Normalization); ok {
+			nrm = v
+			args = append(args[:i:i], args[i+1:]...)
+			break
+		}
+	}
+
 	alpha := defaultAlphabetStr
 	if len(args) > 0 {
-		alpha = args[0].(string)
+		switch v := args[0].(type) {
+		case string:
+			alpha = v
+		case Alphabet:
+			alpha = v.String()
+		case *Alphabet:
+			alpha = v.String()
+		default:
+			return nil, errors.New("invalid alphabet")
+		}
 	}
 
 	ralph := []rune(alpha)
@@ -49,13 +87,24 @@ func newFFX(key, twk []byte,
 	}
 	ralph = ralph[:radix]
 
-	// for both ff1 and ff3-1: radix**minlen >= 1000000
-	//
-	// therefore:
-	//   minlen = ceil(log_radix(1000000))
-	//          = ceil(log_10(1000000) / log_10(radix))
-	//          = ceil(6 / log_10(radix))
-	mintxt := int(math.Ceil(float64(6) / math.Log10(float64(radix))))
+	a, err := NewAlphabet(string(ralph))
+	if err != nil {
+		return nil, err
+	}
+
+	// the fingerprint, if given, is verified against the
+	// portion of the alphabet actually used by the context
+	if len(args) > 1 {
+		if fp, ok := args[1].(string); !ok || fp != a.Fingerprint() {
+			return nil, errors.New("alphabet fingerprint mismatch")
+		}
+	}
+
+	if err := nrm.check(ralph); err != nil {
+		return nil, err
+	}
+
+	mintxt := minTextLen(radix)
 	if mintxt < 2 || mintxt > maxtxt {
 		return nil, errors.New(
 			"unsupported radix/maximum text length combination")
@@ -72,16 +121,12 @@ func newFFX(key, twk []byte,
 		return nil, errors.New("invalid tweak length")
 	}
 
-	block, err := aes.NewCipher(key)
-	if err != nil {
-		return nil, err
-	}
-
 	this := new(ffx)
 
-	this.blockMode = cipher.NewCBCEncrypter(block, cipherIV[:])
+	this.block = block
 
-	this.alpha, _ = NewAlphabet(string(ralph))
+	this.alpha = a
+	this.norm = nrm
 
 	this.len.txt.min = mintxt
 	this.len.txt.max = maxtxt
@@ -92,13 +137,60 @@ func newFFX(key, twk []byte,
 	this.twk = make([]byte, len(twk))
 	copy(this.twk[:], twk[:])
 
+	this.alloc()
+
+	return this, nil
+}
+
+// allocate the state that can't be shared between contexts:
+// the cbc mode and the integers used during encryption
+func (this *ffx) alloc() {
+	this.blockMode = cipher.NewCBCEncrypter(this.block, cipherIV[:])
+
 	this.nA = big.NewInt(0)
 	this.nB = big.NewInt(0)
 	this.mU = big.NewInt(0)
 	this.mV = big.NewInt(0)
 	this.y = big.NewInt(0)
+}
 
-	return this, nil
+// return a copy of the context sharing the key, alphabet,
+// and (immutable) default tweak, but none of its other state
+func (this *ffx) clone() *ffx {
+	c := *this
+	c.alloc()
+	return &c
+}
+
+// return a copy of the context with the default tweak @twk,
+// which may be nil and must be within the context's limits
+func (this *ffx) withTweak(twk []byte) (*ffx, error) {
+	if twk == nil {
+		twk = make([]byte, 0)
+	}
+
+	if len(twk) < this.len.twk.min ||
+		(this.len.twk.max > 0 && len(twk) > this.len.twk.max) {
+		return nil, errors.New("invalid tweak length")
+	}
+
+	c := this.clone()
+	c.twk = make([]byte, len(twk))
+	copy(c.twk, twk)
+
+	return c, nil
+}
+
+// determine the minimum number of numerals allowed in
+// a {plain,cipher}text of the given @radix
+func minTextLen(radix int) int {
+	// for both ff1 and ff3-1: radix**minlen >= 1000000
+	//
+	// therefore:
+	//   minlen = ceil(log_radix(1000000))
+	//          = ceil(log_10(1000000) / log_10(radix))
+	//          = ceil(6 / log_10(radix))
+	return int(math.Ceil(float64(6) / math.Log10(float64(radix))))
 }
 
 // perform an aes-cbc of the input @s (which must be a multiple
@@ -200,6 +292,12 @@ func RunesToBigInt(n *big.Int, alpha *Alphabet, s []rune) *big.Int {
 	return n
 }
 
+// return an integer with the low @bits bits set
+func bitMask(bits uint) *big.Int {
+	m := new(big.Int).Lsh(big.NewInt(1), bits)
+	return m.Sub(m, big.NewInt(1))
+}
+
 // reverse the bytes in a slice. @d and @s may be the
 // same slice but may not otherwise overlap
 func revb(d, s []byte) {
@@ -0,0 +1,125 @@
+//go:build ignore
+
+// generates class_tables.go, the table of non-ASCII character
+// classes used by the ClassPreserving context. the classes are
+// limited to characters assigned as of a fixed version of unicode
+// so that the table, and therefore the ciphertexts produced with
+// it, do not change as the unicode tables of the toolchain do.
+//
+// the scripts may only be appended to, as the position of each
+// is used to identify its classes. once released, the table must
+// not be regenerated with a different version of unicode
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"unicode"
+
+	"golang.org/x/text/unicode/rangetable"
+)
+
+const version = "13.0.0"
+
+var scripts = []*unicode.RangeTable{
+	unicode.Latin,
+	unicode.Greek,
+	unicode.Cyrillic,
+	unicode.Armenian,
+	unicode.Georgian,
+	unicode.Coptic,
+	unicode.Glagolitic,
+	unicode.Cherokee,
+	unicode.Deseret,
+	unicode.Osage,
+	unicode.Old_Hungarian,
+	unicode.Warang_Citi,
+	unicode.Medefaidrin,
+	unicode.Adlam,
+}
+
+var assigned = rangetable.Assigned(version)
+
+func isDigit(c rune) bool {
+	return unicode.Is(assigned, c) && unicode.IsDigit(c)
+}
+
+// return the class of @c as an expression in terms of the
+// constants of class.go, or "" if @c belongs to no class
+func class(c rune) string {
+	if !unicode.Is(assigned, c) {
+		return ""
+	}
+
+	if isDigit(c) {
+		// decimal digits are encoded in contiguous runs
+		// of ten, some of which are adjacent to one another
+		z := c
+		for isDigit(z - 1) {
+			z--
+		}
+		return fmt.Sprintf("classDigits | %#04x", z+(c-z)/10*10)
+	}
+
+	var lower int
+	if unicode.IsLower(c) {
+		lower = 1
+	} else if !unicode.IsUpper(c) {
+		return ""
+	}
+
+	for i, t := range scripts {
+		if unicode.Is(t, c) {
+			return fmt.Sprintf("classLetters | %#04x",
+				int(c>>16)<<8|i<<1|lower)
+		}
+	}
+
+	return ""
+}
+
+func main() {
+	var b bytes.Buffer
+
+	fmt.Fprintf(&b, `// Code generated by "go run gen_classes.go". DO NOT EDIT.
+
+package ubiq
+
+// the version of unicode from which the class table was generated
+const classUnicodeVersion = %q
+
+// the classes of non-ASCII characters, as runs of consecutive
+// characters of the same class, sorted by their first character
+var classTable = []classRun{
+`, version)
+
+	lo, cur := rune(-1), ""
+	for c := rune(0x80); c <= unicode.MaxRune+1; c++ {
+		var k string
+		if c <= unicode.MaxRune {
+			k = class(c)
+		}
+
+		if k != cur {
+			if cur != "" {
+				fmt.Fprintf(&b, "\t{%#04x, %#04x, %s},\n", lo, c-1, cur)
+			}
+			lo, cur = c, k
+		}
+	}
+
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if err := os.WriteFile("class_tables.go", src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -2,4 +2,7 @@ module gitlab.com/ubiqsecurity/ubiq-fpe-go
 
 go 1.18
 
-require golang.org/x/exp v0.0.0-20231006140011-7918f672742d
+require (
+	golang.org/x/exp v0.0.0-20231006140011-7918f672742d
+	golang.org/x/text v0.14.0
+)
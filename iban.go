@@ -0,0 +1,280 @@
+package ubiq
+
+import (
+	"errors"
+	"strconv"
+)
+
+// the structure of the BBAN (the portion following the country
+// code and check digits) for each country, as described by the
+// IBAN registry. each component is a count followed by a type:
+// n for digits, a for upper case letters, and c for upper case
+// letters and digits
+var ibanStructure = map[string]string{
+	"AD": "4n4n12c",
+	"AE": "3n16n",
+	"AL": "8n16c",
+	"AT": "5n11n",
+	"AZ": "4a20c",
+	"BA": "3n3n8n2n",
+	"BE": "3n7n2n",
+	"BG": "4a4n2n8c",
+	"BH": "4a14c",
+	"BI": "5n5n11n2n",
+	"BR": "8n5n10n1a1c",
+	"BY": "4c4n16c",
+	"CH": "5n12c",
+	"CR": "4n14n",
+	"CY": "3n5n16c",
+	"CZ": "4n6n10n",
+	"DE": "8n10n",
+	"DJ": "5n5n11n2n",
+	"DK": "4n9n1n",
+	"DO": "4c20n",
+	"EE": "2n2n11n1n",
+	"EG": "4n4n17n",
+	"ES": "4n4n1n1n10n",
+	"FI": "3n11n",
+	"FK": "2a12n",
+	"FO": "4n9n1n",
+	"FR": "5n5n11c2n",
+	"GB": "4a6n8n",
+	"GE": "2a16n",
+	"GI": "4a15c",
+	"GL": "4n9n1n",
+	"GR": "3n4n16c",
+	"GT": "4c20c",
+	"HN": "4a20n",
+	"HR": "7n10n",
+	"HU": "3n4n1n15n1n",
+	"IE": "4a6n8n",
+	"IL": "3n3n13n",
+	"IQ": "4a3n12n",
+	"IS": "4n2n6n10n",
+	"IT": "1a5n5n12c",
+	"JO": "4a4n18c",
+	"KW": "4a22c",
+	"KZ": "3n13c",
+	"LB": "4n20c",
+	"LC": "4a24c",
+	"LI": "5n12c",
+	"LT": "5n11n",
+	"LU": "3n13c",
+	"LV": "4a13c",
+	"LY": "3n3n15n",
+	"MC": "5n5n11c2n",
+	"MD": "2c18c",
+	"ME": "3n13n2n",
+	"MK": "3n10c2n",
+	"MN": "4n12n",
+	"MR": "5n5n11n2n",
+	"MT": "4a5n18c",
+	"MU": "4a2n2n12n3n3a",
+	"NI": "4a20n",
+	"NL": "4a10n",
+	"NO": "4n6n1n",
+	"OM": "3n16c",
+	"PK": "4a16c",
+	"PL": "8n16n",
+	"PS": "4a21c",
+	"PT": "4n4n11n2n",
+	"QA": "4a21c",
+	"RO": "4a16c",
+	"RS": "3n13n2n",
+	"RU": "9n5n15c",
+	"SA": "2n18c",
+	"SC": "4a2n2n16n3a",
+	"SD": "2n12n",
+	"SE": "3n16n1n",
+	"SI": "5n8n2n",
+	"SK": "4n6n10n",
+	"SM": "1a5n5n12c",
+	"SO": "4n3n12n",
+	"ST": "4n4n11n2n",
+	"SV": "4a20n",
+	"TL": "3n14n2n",
+	"TN": "2n3n13n2n",
+	"TR": "5n1n16c",
+	"UA": "6n19c",
+	"VA": "3n15n",
+	"VG": "4a16n",
+	"XK": "4n10n2n",
+	"YE": "4a4n18c",
+}
+
+// the alphabets corresponding to the component types
+// of the BBAN structure
+var ibanAlphabets = func() map[byte]Alphabet {
+	c, _ := NewAlphabet(DigitsAlphabet.String() + UpperAlphabet.String())
+
+	return map[byte]Alphabet{'n': DigitsAlphabet, 'a': UpperAlphabet, 'c': c}
+}()
+
+// Context structure for encrypting International Bank Account
+// Numbers (IBANs) such that the result is a valid IBAN for the
+// same country
+type IBAN struct {
+	ff1 *FF1
+
+	// formats for the BBANs of each country,
+	// created as they are encountered
+	bban map[string]*MixedRadix
+}
+
+// Allocate a new IBAN context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// the country code is preserved, and the BBAN is encrypted such
+// that each position retains the character type (digits, letters,
+// or both) specified for it by the IBAN registry. the check digits
+// are then recomputed. note that any national check digits within
+// the BBAN are not maintained
+func NewIBAN(ff1 *FF1) (*IBAN, error) {
+	return &IBAN{
+		ff1:  ff1,
+		bban: make(map[string]*MixedRadix),
+	}, nil
+}
+
+// return the format of the BBAN for the country @cc
+func (this *IBAN) format(cc string) (*MixedRadix, error) {
+	if mr, ok := this.bban[cc]; ok {
+		return mr, nil
+	}
+
+	st, ok := ibanStructure[cc]
+	if !ok {
+		return nil, errors.New("unsupported country code")
+	}
+
+	var args []interface{}
+	for i := 0; i < len(st); {
+		j := i
+		for st[j] >= '0' && st[j] <= '9' {
+			j++
+		}
+
+		n, _ := strconv.Atoi(st[i:j])
+		for k := 0; k < n; k++ {
+			args = append(args, ibanAlphabets[st[j]])
+		}
+
+		i = j + 1
+	}
+
+	mr, err := NewMixedRadix(this.ff1, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	this.bban[cc] = mr
+	return mr, nil
+}
+
+// compute the ISO 7064 mod 97-10 remainder of the IBAN @X,
+// which must contain only digits and upper case letters. the
+// country code and check digits are moved to the end and each
+// letter is replaced by two digits (A = 10, B = 11, ...)
+func ibanMod97(X []rune) (int, bool) {
+	r := 0
+
+	for i := range X {
+		c := X[(i+4)%len(X)]
+
+		switch {
+		case c >= '0' && c <= '9':
+			r = (r*10 + int(c-'0')) % 97
+		case c >= 'A' && c <= 'Z':
+			r = (r*100 + int(c-'A') + 10) % 97
+		default:
+			return 0, false
+		}
+	}
+
+	return r, true
+}
+
+func (this *IBAN) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	// IBANs are commonly printed in groups of four
+	// characters separated by spaces, which are preserved
+	var pos []int
+	for i, r := range X {
+		if r != ' ' {
+			pos = append(pos, i)
+		}
+	}
+
+	if len(pos) < 5 {
+		return nil, errors.New("invalid text length")
+	}
+
+	I := make([]rune, len(pos))
+	for i, p := range pos {
+		I[i] = X[p]
+	}
+
+	mr, err := this.format(string(I[:2]))
+	if err != nil {
+		return nil, err
+	} else if len(I) != mr.Len()+4 {
+		return nil, errors.New("invalid text length")
+	}
+
+	if r, ok := ibanMod97(I); !ok || r != 1 {
+		return nil, errors.New("invalid check digits")
+	}
+
+	B, err := mr.cipher(I[4:], T, enc)
+	if err != nil {
+		return nil, err
+	}
+	copy(I[4:], B)
+
+	I[2], I[3] = '0', '0'
+	r, _ := ibanMod97(I)
+	I[2] = rune('0' + (98-r)/10)
+	I[3] = rune('0' + (98-r)%10)
+
+	Y := make([]rune, len(X))
+	copy(Y, X)
+	for i, p := range pos {
+		Y[p] = I[i]
+	}
+
+	return Y, nil
+}
+
+func (this *IBAN) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt an IBAN @X with the tweak @T. the IBAN must be in upper
+// case and may contain spaces, which are preserved
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *IBAN) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *IBAN) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt an IBAN @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *IBAN) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
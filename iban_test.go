@@ -0,0 +1,109 @@
+package ubiq
+
+import (
+	"testing"
+)
+
+func TestIBAN(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	iban, err := NewIBAN(ff1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"GB82 WEST 1234 5698 7654 32",
+		"DE89370400440532013000",
+		"FR1420041010050500013M02606",
+		"NL91ABNA0417164300",
+		"BR1800360305000010009795493C1",
+		"MU17BOMM0101101030300200000MUR",
+		"NO9386011117947",
+		"MR1300020001010000123456753",
+		"VA59001123000012345678",
+		"EG380019000500000000263180002",
+		"IQ98NBIQ850123456789012",
+		"BY13NBRB3600900000002Z00AB00",
+		"LY83002048000020100120361",
+		"SD2129010501234001",
+		"RU0304452522540817810538091310419",
+		"SC18SSCB11010000000000001497USD",
+		"ST68000100010051845310112",
+		"LC55HEMM000100010012001200023015",
+		"TL380080012345678910157",
+		"SV62CENR00000000000000700025",
+	} {
+		CT, err := iban.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		X, Y := []rune(PT), []rune(CT)
+		if len(X) != len(Y) || CT[:2] != PT[:2] {
+			t.Fatal(CT)
+		}
+
+		var I []rune
+		for i := range Y {
+			if X[i] == ' ' {
+				if Y[i] != ' ' {
+					t.Fatal(CT)
+				}
+				continue
+			}
+			I = append(I, Y[i])
+		}
+		if r, ok := ibanMod97(I); !ok || r != 1 {
+			t.Fatal(CT + " has invalid check digits")
+		}
+
+		mr, _ := iban.format(PT[:2])
+		if _, err := mr.rank(I[4:]); err != nil {
+			t.Fatal(CT + " does not match the country's structure")
+		}
+
+		out, err := iban.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestIBANInvalid(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	iban, _ := NewIBAN(ff1)
+
+	for _, PT := range []string{
+		"GB83WEST12345698765432", // bad check digits
+		"GB82WEST123456987654",   // too short
+		"GB82WEST1234569876543X", // bad structure
+		"ZZ82WEST12345698765432", // unknown country
+		"gb82west12345698765432", // lower case
+	} {
+		if _, err := iban.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
+
+func TestIBANStructure(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	iban, _ := NewIBAN(ff1)
+
+	for cc := range ibanStructure {
+		mr, err := iban.format(cc)
+		if err != nil {
+			t.Fatal(cc, err)
+		}
+		if l := mr.Len() + 4; l < 15 || l > 34 {
+			t.Fatal(cc, l)
+		}
+	}
+}
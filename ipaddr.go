@@ -0,0 +1,165 @@
+package ubiq
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"net/netip"
+)
+
+// Context structure for encrypting IPv4 and IPv6 addresses
+// such that the results are valid addresses of the same type
+type IPAddr struct {
+	ff1 *FF1
+}
+
+// Allocate a new IP address context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// two modes of encryption are supported: Encrypt and Decrypt are
+// prefix-preserving, while EncryptHost and DecryptHost encrypt
+// only the host portion of an address within a given network
+func NewIPAddr(ff1 *FF1) (*IPAddr, error) {
+	return &IPAddr{ff1: ff1}, nil
+}
+
+// generate the pad used by the prefix-preserving mode for
+// addresses of @bits bits and the tweak @T. the pad takes the
+// place of the second half of the key in the original scheme
+func (this *IPAddr) pad(bits int, T []byte) []byte {
+	if T == nil {
+		T = this.ff1.ctx.twk
+	}
+
+	P := make([]byte, 16+((len(T)+15)/16)*16)
+	P[0] = byte(bits)
+	binary.BigEndian.PutUint32(P[1:5], uint32(len(T)))
+	copy(P[16:], T)
+
+	pad := make([]byte, 16)
+	this.ff1.ctx.prf(pad, P)
+
+	return pad
+}
+
+// the prefix-preserving scheme is that of Crypto-PAn:
+// the i'th bit of the output is the i'th bit of the input
+// xor'd with the most significant bit of the encryption of
+// a block consisting of the first i bits of the (plaintext)
+// address followed by the remaining bits of the pad
+func (this *IPAddr) cipher(addr netip.Addr, T []byte, enc bool) (
+	netip.Addr, error) {
+	if !addr.IsValid() {
+		return netip.Addr{}, errors.New("invalid address")
+	}
+
+	zone := addr.Zone()
+	a := addr.AsSlice()
+	bits := addr.BitLen()
+	pad := this.pad(bits, T)
+
+	out := make([]byte, len(a))
+	copy(out, a)
+
+	// the plaintext bits, which, when decrypting,
+	// are recovered one at a time into the output
+	src := a
+	if !enc {
+		src = out
+	}
+
+	B := make([]byte, 16)
+	for i := 0; i < bits; i++ {
+		copy(B, pad)
+		copy(B, src[:i/8])
+		if r := i % 8; r != 0 {
+			m := byte(0xff << (8 - r))
+			B[i/8] = (src[i/8] & m) | (pad[i/8] & ^m)
+		}
+
+		this.ff1.ctx.ciph(B, B)
+
+		out[i/8] ^= (B[0] >> 7) << (7 - i%8)
+	}
+
+	res, _ := netip.AddrFromSlice(out)
+	return res.WithZone(zone), nil
+}
+
+// Encrypt the address @addr with the tweak @T, preserving
+// prefixes: any two addresses that share a prefix of n bits
+// will also share a prefix of n bits after encryption
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *IPAddr) Encrypt(addr netip.Addr, T []byte) (netip.Addr, error) {
+	return this.cipher(addr, T, true)
+}
+
+// Decrypt the address @addr, which was encrypted by Encrypt,
+// with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *IPAddr) Decrypt(addr netip.Addr, T []byte) (netip.Addr, error) {
+	return this.cipher(addr, T, false)
+}
+
+func (this *IPAddr) cipherHost(addr netip.Addr, pfx netip.Prefix,
+	T []byte, enc bool) (netip.Addr, error) {
+	if !addr.IsValid() || !pfx.IsValid() {
+		return netip.Addr{}, errors.New("invalid address")
+	} else if !pfx.Contains(addr) {
+		return netip.Addr{}, errors.New("address not within network")
+	}
+
+	h := addr.BitLen() - pfx.Bits()
+	if h < 1 {
+		return netip.Addr{}, errors.New("network contains a single address")
+	}
+
+	a := addr.AsSlice()
+
+	m := new(big.Int).Lsh(big.NewInt(1), uint(h))
+	n := new(big.Int).SetBytes(a)
+	n.Mod(n, m)
+
+	n, err := this.ff1.cycleWalk(n, m, T, enc)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	// replace the host bits of the address
+	y := new(big.Int).SetBytes(a)
+	y.Rsh(y, uint(h))
+	y.Lsh(y, uint(h))
+	y.Or(y, n)
+	y.FillBytes(a)
+
+	res, _ := netip.AddrFromSlice(a)
+	return res.WithZone(addr.Zone()), nil
+}
+
+// Encrypt the host bits of the address @addr, which must be within
+// the network @pfx, with the tweak @T. the network bits are left
+// unchanged. note that networks with few host bits result in small
+// domains, which are easy to guess
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *IPAddr) EncryptHost(addr netip.Addr, pfx netip.Prefix,
+	T []byte) (netip.Addr, error) {
+	return this.cipherHost(addr, pfx, T, true)
+}
+
+// Decrypt the host bits of the address @addr, which must be within
+// the network @pfx, with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *IPAddr) DecryptHost(addr netip.Addr, pfx netip.Prefix,
+	T []byte) (netip.Addr, error) {
+	return this.cipherHost(addr, pfx, T, false)
+}
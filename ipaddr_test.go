@@ -0,0 +1,121 @@
+package ubiq
+
+import (
+	"net/netip"
+	"testing"
+)
+
+// the number of leading bits shared by @a and @b
+func commonPrefixLen(a, b netip.Addr) int {
+	x, y := a.AsSlice(), b.AsSlice()
+	for i := 0; i < len(x)*8; i++ {
+		m := byte(0x80 >> (i % 8))
+		if x[i/8]&m != y[i/8]&m {
+			return i
+		}
+	}
+	return len(x) * 8
+}
+
+func TestIPAddrPrefixPreserving(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ip, err := NewIPAddr(ff1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, set := range [][]string{
+		{"192.168.1.1", "192.168.1.2", "192.168.200.7", "10.0.0.1",
+			"255.255.255.255", "0.0.0.0"},
+		{"2001:db8::1", "2001:db8::2", "2001:db8:1::1", "fe80::1%eth0",
+			"::ffff:192.168.1.1"},
+	} {
+		var pt, ct []netip.Addr
+
+		for _, s := range set {
+			a := netip.MustParseAddr(s)
+
+			c, err := ip.Encrypt(a, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if c.BitLen() != a.BitLen() || c.Zone() != a.Zone() {
+				t.Fatal(c)
+			}
+
+			out, err := ip.Decrypt(c, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if out != a {
+				t.Fatal(out.String() + " != " + s)
+			}
+
+			pt = append(pt, a)
+			ct = append(ct, c)
+		}
+
+		for i := range pt {
+			for j := range pt {
+				if commonPrefixLen(pt[i], pt[j]) !=
+					commonPrefixLen(ct[i], ct[j]) {
+					t.Fatal(pt[i], pt[j], ct[i], ct[j])
+				}
+			}
+		}
+	}
+}
+
+func TestIPAddrTweak(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	ip, _ := NewIPAddr(ff1)
+
+	a := netip.MustParseAddr("192.168.1.1")
+	x, _ := ip.Encrypt(a, []byte("one"))
+	y, _ := ip.Encrypt(a, []byte("two"))
+
+	if x == y {
+		t.FailNow()
+	}
+}
+
+func TestIPAddrHost(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	ip, _ := NewIPAddr(ff1)
+
+	for _, c := range []struct{ addr, pfx string }{
+		{"10.1.2.3", "10.0.0.0/8"},
+		{"172.16.5.4", "172.16.0.0/16"},
+		{"192.168.1.77", "192.168.1.0/24"},
+		{"2001:db8::1234", "2001:db8::/64"},
+	} {
+		a := netip.MustParseAddr(c.addr)
+		p := netip.MustParsePrefix(c.pfx)
+
+		e, err := ip.EncryptHost(a, p, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !p.Contains(e) {
+			t.Fatal(e)
+		}
+
+		out, err := ip.DecryptHost(e, p, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != a {
+			t.Fatal(out)
+		}
+	}
+
+	_, err := ip.EncryptHost(netip.MustParseAddr("10.1.2.3"),
+		netip.MustParsePrefix("192.168.0.0/16"), nil)
+	if err == nil {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,158 @@
+package ubiq
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// the bits of the first octet of a mac address that indicate
+// a multicast address and a locally administered address
+const macFlags = 0x03
+
+// Context structure for encrypting MAC addresses such that the
+// result is a MAC address in the same textual form
+type MAC struct {
+	ff1 *FF1
+
+	oui, flags bool
+}
+
+// Allocate a new MAC context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// addresses may be written as six groups of two hexadecimal digits
+// separated by colons or dashes, or as three groups of four digits
+// separated by dots. the separators and the case of the digits are
+// preserved.
+//
+// if @oui is true, the organizationally unique identifier (the first
+// three octets) is left unchanged, and only the last three octets
+// are encrypted. otherwise, if @flags is true, the multicast and
+// locally administered bits are left unchanged, and the remaining
+// 46 bits are encrypted. if neither is true, all 48 bits are encrypted
+func NewMAC(ff1 *FF1, oui, flags bool) (*MAC, error) {
+	return &MAC{ff1: ff1, oui: oui, flags: flags}, nil
+}
+
+// parse the mac address @X, returning its hexadecimal
+// digits and the character separating the groups
+func macParse(X string) (string, byte, error) {
+	var sep byte
+	var grp int
+
+	switch {
+	case len(X) == 17 && (X[2] == ':' || X[2] == '-'):
+		sep, grp = X[2], 2
+	case len(X) == 14 && X[4] == '.':
+		sep, grp = '.', 4
+	default:
+		return "", 0, errors.New("invalid mac address")
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(X); i++ {
+		if i%(grp+1) == grp {
+			if X[i] != sep {
+				return "", 0, errors.New("invalid mac address")
+			}
+		} else {
+			b.WriteByte(X[i])
+		}
+	}
+
+	return b.String(), sep, nil
+}
+
+func (this *MAC) cipher(X string, T []byte, enc bool) (string, error) {
+	h, sep, err := macParse(X)
+	if err != nil {
+		return "", err
+	}
+
+	b, err := hex.DecodeString(h)
+	if err != nil {
+		return "", errors.New("invalid mac address")
+	}
+
+	var a uint64
+	for _, v := range b {
+		a = a<<8 | uint64(v)
+	}
+
+	// the number of encrypted bits; the remaining
+	// bits are above them and are left unchanged
+	var bits uint
+	var flags uint64
+	switch {
+	case this.oui:
+		bits = 24
+	case this.flags:
+		// the flags are the least significant bits of the
+		// first octet. they are removed from the encrypted
+		// value and restored afterward
+		bits = 46
+		flags = a >> 40 & macFlags
+		a = a>>42<<40 | a&(1<<40-1)
+	default:
+		bits = 48
+	}
+
+	m := uint64(1)<<bits - 1
+
+	n, err := this.ff1.cycleWalk(
+		new(big.Int).SetUint64(a&m),
+		new(big.Int).SetUint64(m+1), T, enc)
+	if err != nil {
+		return "", err
+	}
+
+	a = a&^m | n.Uint64()
+	if bits == 46 {
+		a = a>>40<<42 | flags<<40 | a&(1<<40-1)
+	}
+
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i] = byte(a)
+		a >>= 8
+	}
+
+	s := hex.EncodeToString(b)
+	if strings.ToUpper(h) == h && strings.ToLower(h) != h {
+		s = strings.ToUpper(s)
+	}
+
+	grp := 2
+	if sep == '.' {
+		grp = 4
+	}
+
+	var Y strings.Builder
+	for i := 0; i < len(s); i += grp {
+		if i > 0 {
+			Y.WriteByte(sep)
+		}
+		Y.WriteString(s[i : i+grp])
+	}
+
+	return Y.String(), nil
+}
+
+// Encrypt a mac address @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *MAC) Encrypt(X string, T []byte) (string, error) {
+	return this.cipher(X, T, true)
+}
+
+// Decrypt a mac address @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *MAC) Decrypt(X string, T []byte) (string, error) {
+	return this.cipher(X, T, false)
+}
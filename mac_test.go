@@ -0,0 +1,108 @@
+package ubiq
+
+import (
+	"testing"
+)
+
+func testMAC(t *testing.T, oui, flags bool) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mac, err := NewMAC(ff1, oui, flags)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"00:1a:2b:3c:4d:5e",
+		"00-1A-2B-3C-4D-5E",
+		"001a.2b3c.4d5e",
+		"03:00:00:00:00:00",
+		"ff:ff:ff:ff:ff:ff",
+		"02:00:00:00:00:01",
+	} {
+		CT, err := mac.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(CT) != len(PT) || CT == PT {
+			t.Fatal(CT)
+		}
+
+		h, sep, err := macParse(CT)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, psep, _ := macParse(PT)
+		if sep != psep {
+			t.Fatal(CT)
+		}
+
+		for i := range h {
+			c, d := h[i], p[i]
+			if c >= 'a' && c <= 'f' && d >= 'A' && d <= 'F' ||
+				c >= 'A' && c <= 'F' && d >= 'a' && d <= 'f' {
+				t.Fatal(CT)
+			}
+		}
+
+		switch {
+		case oui:
+			if h[:6] != p[:6] {
+				t.Fatal(CT)
+			}
+		case flags:
+			x, _ := hexValue(h[1])
+			y, _ := hexValue(p[1])
+			if x&macFlags != y&macFlags {
+				t.Fatal(CT)
+			}
+		}
+
+		out, err := mac.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	for _, PT := range []string{
+		"00:1a:2b:3c:4d",
+		"00:1a-2b:3c:4d:5e",
+		"001a.2b3c:4d5e",
+		"00:1a:2b:3c:4d:5g",
+	} {
+		if _, err := mac.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
+
+func hexValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+func TestMAC(t *testing.T) {
+	testMAC(t, false, false)
+}
+
+func TestMACPreserveOUI(t *testing.T) {
+	testMAC(t, true, false)
+}
+
+func TestMACPreserveFlags(t *testing.T) {
+	testMAC(t, false, true)
+}
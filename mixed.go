@@ -0,0 +1,195 @@
+package ubiq
+
+import (
+	"errors"
+	"math/big"
+)
+
+// a single position within a mixed-radix format. if the alphabet
+// is empty, the position holds the literal character, @lit, which
+// is passed through unencrypted
+type mixedPos struct {
+	alpha Alphabet
+	lit   rune
+}
+
+// Context structure for encrypting fixed-length strings in which
+// each position draws its characters from a different alphabet
+type MixedRadix struct {
+	ff1 *FF1
+
+	pos []mixedPos
+	// the number of distinct values representable by the format,
+	// i.e. the product of the lengths of all of the alphabets
+	dom *big.Int
+}
+
+// Allocate a new mixed-radix context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// @args describe the format, one position at a time, from left to
+// right. each argument may be:
+//   - an Alphabet (or a pointer to one) from which the character at
+//     the corresponding position is drawn
+//   - a rune that must appear, as is, at the corresponding position
+//   - a string, each character of which is treated as a literal rune
+//
+// For example, a format for license plates like "ABC-1234" could be
+// specified with: upper, upper, upper, '-', digit, digit, digit, digit
+func NewMixedRadix(ff1 *FF1, args ...interface{}) (*MixedRadix, error) {
+	this := new(MixedRadix)
+
+	this.ff1 = ff1
+	this.dom = big.NewInt(1)
+
+	for _, arg := range args {
+		switch v := arg.(type) {
+		case Alphabet:
+			if err := this.addAlphabet(v); err != nil {
+				return nil, err
+			}
+		case *Alphabet:
+			if err := this.addAlphabet(*v); err != nil {
+				return nil, err
+			}
+		case rune:
+			this.pos = append(this.pos, mixedPos{lit: v})
+		case string:
+			for _, r := range v {
+				this.pos = append(this.pos, mixedPos{lit: r})
+			}
+		default:
+			return nil, errors.New("unsupported format specifier")
+		}
+	}
+
+	if this.dom.Cmp(big.NewInt(2)) < 0 {
+		return nil, errors.New("format contains too few values")
+	}
+
+	return this, nil
+}
+
+func (this *MixedRadix) addAlphabet(alpha Alphabet) error {
+	if alpha.Len() < 1 {
+		return errors.New("empty alphabet")
+	}
+
+	this.pos = append(this.pos, mixedPos{alpha: alpha})
+	this.dom.Mul(this.dom, big.NewInt(int64(alpha.Len())))
+
+	return nil
+}
+
+// Len returns the number of characters in strings of the format
+func (this *MixedRadix) Len() int {
+	return len(this.pos)
+}
+
+// convert the string @X to its integer representation within
+// the domain of the format. the leftmost position is the most
+// significant
+func (this *MixedRadix) rank(X []rune) (*big.Int, error) {
+	if len(X) != len(this.pos) {
+		return nil, errors.New("invalid text length")
+	}
+
+	n := big.NewInt(0)
+	t := big.NewInt(0)
+
+	for i, p := range this.pos {
+		if p.alpha.Len() == 0 {
+			if X[i] != p.lit {
+				return nil, errors.New("invalid character in input")
+			}
+			continue
+		}
+
+		c := p.alpha.PosOf(X[i])
+		if c < 0 {
+			return nil, errors.New("invalid character in input")
+		}
+
+		// n = n * radix + c
+		t.SetInt64(int64(p.alpha.Len()))
+		n.Mul(n, t)
+		t.SetInt64(int64(c))
+		n.Add(n, t)
+	}
+
+	return n, nil
+}
+
+// convert the integer @n back to a string of the format;
+// this is the inverse of rank()
+func (this *MixedRadix) unrank(n *big.Int) []rune {
+	X := make([]rune, len(this.pos))
+
+	q := new(big.Int).Set(n)
+	r := big.NewInt(0)
+	t := big.NewInt(0)
+
+	for i := len(this.pos) - 1; i >= 0; i-- {
+		p := &this.pos[i]
+
+		if p.alpha.Len() == 0 {
+			X[i] = p.lit
+			continue
+		}
+
+		t.SetInt64(int64(p.alpha.Len()))
+		q.DivMod(q, t, r)
+		X[i] = p.alpha.ValAt(int(r.Int64()))
+	}
+
+	return X
+}
+
+func (this *MixedRadix) cipher(X []rune, T []byte, enc bool) (
+	[]rune, error) {
+	n, err := this.rank(X)
+	if err != nil {
+		return nil, err
+	}
+
+	n, err = this.ff1.cycleWalk(n, this.dom, T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return this.unrank(n), nil
+}
+
+func (this *MixedRadix) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt a string @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *MixedRadix) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *MixedRadix) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt a string @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *MixedRadix) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
@@ -0,0 +1,200 @@
+package ubiq
+
+import (
+	"math/big"
+	"testing"
+)
+
+var testMixedKey = []byte{
+	0x2b, 0x7e, 0x15, 0x16, 0x28, 0xae, 0xd2, 0xa6,
+	0xab, 0xf7, 0x15, 0x88, 0x09, 0xcf, 0x4f, 0x3c,
+}
+
+func TestFF1CycleWalkBinary(t *testing.T) {
+	// when the domain is exactly a power of two, no walking
+	// occurs, and the result must match that of encrypting
+	// the equivalent binary string directly
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	PT := "0110100011101011001011101"
+	CT, err := ff1.Encrypt(PT, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	x, _ := new(big.Int).SetString(PT, 2)
+	m := new(big.Int).Lsh(big.NewInt(1), uint(len(PT)))
+
+	y, err := ff1.cycleWalk(x, m, nil, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if out := BigIntToRunes(&ff1.ctx.alpha, y, len(PT)); string(out) != CT {
+		t.Fatal(string(out) + " != " + CT)
+	}
+
+	y, err = ff1.cycleWalk(y, m, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if y.Cmp(x) != 0 {
+		t.FailNow()
+	}
+}
+
+func TestFF1CycleWalkRange(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := big.NewInt(1234567)
+	for i := int64(0); i < 100; i++ {
+		x := big.NewInt(i * 12345)
+
+		y, err := ff1.cycleWalk(x, m, nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if y.Sign() < 0 || y.Cmp(m) >= 0 {
+			t.Fatal("result outside of domain")
+		}
+
+		y, err = ff1.cycleWalk(y, m, nil, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if y.Cmp(x) != 0 {
+			t.FailNow()
+		}
+	}
+
+	_, err = ff1.cycleWalk(m, m, nil, true)
+	if err == nil {
+		t.FailNow()
+	}
+}
+
+func TestMixedRadixLicensePlate(t *testing.T) {
+	upper, _ := NewAlphabet("ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+	digit, _ := NewAlphabet("0123456789")
+
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mr, err := NewMixedRadix(ff1,
+		upper, upper, upper, '-', &digit, digit, digit, digit)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{"ABC-1234", "ZZZ-9999", "AAA-0000"} {
+		CT, err := mr.Encrypt(PT, []byte("plate"))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for i, r := range CT {
+			if i < 3 && upper.PosOf(r) < 0 ||
+				i == 3 && r != '-' ||
+				i > 3 && digit.PosOf(r) < 0 {
+				t.Fatal("format not preserved: " + CT)
+			}
+		}
+
+		out, err := mr.Decrypt(CT, []byte("plate"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	if _, err := mr.Encrypt("ABC-123A", nil); err == nil {
+		t.FailNow()
+	}
+	if _, err := mr.Encrypt("ABC_1234", nil); err == nil {
+		t.FailNow()
+	}
+	if _, err := mr.Encrypt("ABC-12345", nil); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestMixedRadixBadFormat(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewMixedRadix(ff1, "AB-"); err == nil {
+		t.FailNow()
+	}
+	if _, err := NewMixedRadix(ff1, 1.5); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestFF1CycleWalkSmall(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// small domains are permuted
+	for _, m := range []int64{2, 3, 10, 100} {
+		M := big.NewInt(m)
+		seen := make(map[int64]bool)
+
+		for x := int64(0); x < m; x++ {
+			y, err := ff1.cycleWalk(big.NewInt(x), M, nil, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if y.Sign() < 0 || y.Cmp(M) >= 0 || seen[y.Int64()] {
+				t.Fatal(m, x, y)
+			}
+			seen[y.Int64()] = true
+
+			z, err := ff1.cycleWalk(y, M, nil, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if z.Int64() != x {
+				t.Fatal(m, x, z)
+			}
+		}
+	}
+
+	// on either side of the threshold at which
+	// ranking is replaced by walking
+	for _, m := range []int64{cycleWalkRankMax, cycleWalkRankMax + 1} {
+		M := big.NewInt(m)
+
+		for _, x := range []int64{0, 1, m / 2, m - 1} {
+			y, err := ff1.cycleWalk(big.NewInt(x), M, nil, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if y.Sign() < 0 || y.Cmp(M) >= 0 {
+				t.Fatal(m, x, y)
+			}
+
+			z, err := ff1.cycleWalk(y, M, nil, false)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if z.Int64() != x {
+				t.Fatal(m, x, z)
+			}
+		}
+	}
+}
@@ -0,0 +1,235 @@
+package ubiq
+
+import (
+	"errors"
+)
+
+// the characters allowed in the document number field of a machine
+// readable zone, excluding the filler used to pad short numbers
+var mrzAlphabet, _ = NewAlphabet("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+
+// the length of the document number field and
+// the filler with which shorter numbers are padded
+const (
+	mrzNumberLen = 9
+	mrzFiller    = '<'
+)
+
+// the length of the three lines, concatenated, of the machine
+// readable zone of TD1 documents (e.g. id cards), and the lengths
+// of the second line of the machine readable zones of TD2 (e.g.
+// id cards) and TD3 (passports) documents
+const (
+	mrzTD1Len = 3 * mrzTD1LineLen
+	mrzTD2Len = 36
+	mrzTD3Len = 44
+)
+
+// the length of each line of a TD1 machine readable zone, and the
+// offset of the document number within it, following the document
+// code and issuing state. in the other forms of input, the document
+// number comes first
+const (
+	mrzTD1LineLen   = 30
+	mrzTD1NumberOff = 5
+)
+
+// compute the ICAO 9303 check digit over @X. digits have their own
+// values, letters have values from 10 (A) to 35 (Z), and the filler
+// has a value of 0. the values are weighted 7, 3, 1, repeating, and
+// the check digit is their sum modulo 10
+func mrzCheck(X []rune) (rune, bool) {
+	w := [3]int{7, 3, 1}
+
+	s := 0
+	for i, r := range X {
+		var v int
+		switch {
+		case r >= '0' && r <= '9':
+			v = int(r - '0')
+		case r >= 'A' && r <= 'Z':
+			v = int(r-'A') + 10
+		case r == mrzFiller:
+		default:
+			return 0, false
+		}
+		s += v * w[i%3]
+	}
+
+	return rune('0' + s%10), true
+}
+
+// compute the composite check digit over a TD1 machine readable
+// zone or the second line of a TD2 or TD3 machine readable zone. it
+// covers the document number, date of birth, date of expiry and
+// optional data fields along with their check digits
+func mrzComposite(X []rune) (rune, bool) {
+	var C []rune
+	if len(X) == mrzTD1Len {
+		C = append(C, X[5:30]...)
+		C = append(C, X[30:37]...)
+		C = append(C, X[38:45]...)
+		C = append(C, X[48:59]...)
+	} else {
+		C = append(C, X[0:10]...)
+		C = append(C, X[13:20]...)
+		C = append(C, X[21:len(X)-1]...)
+	}
+	return mrzCheck(C)
+}
+
+// return the position of the composite check digit within @X, which
+// is at the end of the second line of a TD1 machine readable zone and
+// at the end of the input otherwise
+func mrzCompositePos(X []rune) int {
+	if len(X) == mrzTD1Len {
+		return 2*mrzTD1LineLen - 1
+	}
+	return len(X) - 1
+}
+
+// Context structure for encrypting travel document numbers and
+// the machine readable zones (MRZs) in which they appear such that
+// the results carry valid ICAO 9303 check digits
+type MRZ struct {
+	// contexts for document numbers of each
+	// length, the shortest being a single character
+	mr [mrzNumberLen]*MixedRadix
+}
+
+// Allocate a new MRZ context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// the characters of the document number are encrypted using upper
+// case letters and digits. numbers shorter than nine characters are
+// padded with the '<' filler, which is left in place, so that the
+// encrypted number has the same length as the original. note that
+// very short numbers form very small domains, which are easy to guess
+func NewMRZ(ff1 *FF1) (*MRZ, error) {
+	this := &MRZ{}
+
+	args := make([]interface{}, mrzNumberLen)
+	for i := range args {
+		args[i] = mrzAlphabet
+	}
+
+	for i := range this.mr {
+		mr, err := NewMixedRadix(ff1, args[:i+1]...)
+		if err != nil {
+			return nil, err
+		}
+		this.mr[i] = mr
+	}
+
+	return this, nil
+}
+
+func (this *MRZ) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	// the offset of the document number, and whether it is
+	// followed by its check digit and has a composite check digit
+	var off int
+	var check, comp bool
+
+	switch len(X) {
+	case mrzNumberLen:
+	case mrzNumberLen + 1:
+		check = true
+	case mrzTD1Len:
+		off, check, comp = mrzTD1NumberOff, true, true
+	case mrzTD2Len, mrzTD3Len:
+		check, comp = true, true
+	default:
+		return nil, errors.New("invalid text length")
+	}
+
+	num := X[off : off+mrzNumberLen]
+
+	if check {
+		if c, ok := mrzCheck(num); !ok {
+			return nil, errors.New("invalid character in input")
+		} else if c != X[off+mrzNumberLen] {
+			return nil, errors.New("invalid document number check digit")
+		}
+	}
+
+	if comp {
+		if c, ok := mrzComposite(X); !ok {
+			return nil, errors.New("invalid character in input")
+		} else if c != X[mrzCompositePos(X)] {
+			return nil, errors.New("invalid composite check digit")
+		}
+	}
+
+	// the number consists of the characters preceding the filler,
+	// which may only appear at the end of the field
+	n := mrzNumberLen
+	for n > 0 && num[n-1] == mrzFiller {
+		n--
+	}
+	if n == 0 {
+		return nil, errors.New("invalid document number")
+	}
+	for _, r := range num[:n] {
+		if r == mrzFiller {
+			return nil, errors.New("invalid document number")
+		}
+	}
+
+	N, err := this.mr[n-1].cipher(num[:n], T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	Y := make([]rune, len(X))
+	copy(Y, X)
+	copy(Y[off:], N)
+
+	if check {
+		Y[off+mrzNumberLen], _ = mrzCheck(Y[off : off+mrzNumberLen])
+	}
+	if comp {
+		Y[mrzCompositePos(Y)], _ = mrzComposite(Y)
+	}
+
+	return Y, nil
+}
+
+func (this *MRZ) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt @X with the tweak @T. @X may be a 9-character document
+// number, a document number followed by its check digit, the three
+// lines of a TD1 MRZ concatenated without separators (90 characters),
+// or the second line of a TD2 (36 characters) or TD3 (44 characters)
+// MRZ. any check digits present must be valid and are recomputed
+// after the document number is encrypted
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *MRZ) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *MRZ) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt @X with the tweak @T. @X takes any of the
+// forms accepted by Encrypt
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *MRZ) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
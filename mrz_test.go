@@ -0,0 +1,156 @@
+package ubiq
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMRZCheck(t *testing.T) {
+	for _, X := range []string{
+		"L898902C36UTO7408122F1204159ZE184226B<<<<<10",
+		"D231458907UTO7408122F1204159<<<<<<<6",
+	} {
+		R := []rune(X)
+		if c, _ := mrzCheck(R[:9]); c != R[9] {
+			t.Fatal(X)
+		}
+		if c, _ := mrzComposite(R); c != R[len(R)-1] {
+			t.Fatal(X)
+		}
+	}
+}
+
+func TestMRZ(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mrz, err := NewMRZ(ff1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"L898902C3",
+		"L898902C36",
+		"AB1234<<<",
+		"X<<<<<<<<",
+		"AB1234<<<1",
+		"L898902C36UTO7408122F1204159ZE184226B<<<<<10",
+		"D231458907UTO7408122F1204159<<<<<<<6",
+	} {
+		CT, err := mrz.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(CT) != len(PT) || CT[9:] == PT[9:] && CT[:9] == PT[:9] {
+			t.Fatal(CT)
+		}
+
+		// the filler is kept at the end of the number
+		// and does not appear anywhere else within it
+		n := strings.TrimRight(PT[:9], "<")
+		if CT[len(n):9] != PT[len(n):9] ||
+			strings.ContainsRune(CT[:len(n)], '<') {
+			t.Fatal(CT)
+		}
+
+		R := []rune(CT)
+		if len(R) > 9 {
+			if c, _ := mrzCheck(R[:9]); c != R[9] {
+				t.Fatal(CT)
+			}
+		}
+		if len(R) > 10 {
+			if CT[10:len(CT)-1] != PT[10:len(PT)-1] {
+				t.Fatal(CT)
+			}
+			if c, _ := mrzComposite(R); c != R[len(R)-1] {
+				t.Fatal(CT)
+			}
+		}
+
+		out, err := mrz.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	for _, PT := range []string{
+		"L898902C",   // too short
+		"L898902C37", // bad check digit
+		"l898902C3",  // lower case
+		"AB<1234<<",  // filler within the number
+		"<<<<<<<<<",  // no number
+		"L898902C36UTO7408122F1204159ZE184226B<<<<<11", // bad composite
+	} {
+		if _, err := mrz.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
+
+func TestMRZTD1(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	mrz, _ := NewMRZ(ff1)
+
+	for _, PT := range []string{
+		"I<UTOD231458907<<<<<<<<<<<<<<<" +
+			"7408122F1204159UTO<<<<<<<<<<<6" +
+			"ERIKSSON<<ANNA<MARIA<<<<<<<<<<",
+		"I<UTOAB1234<<<1<<<<<<<<<<<<<<<" +
+			"7408122F1204159UTO<<<<<<<<<<<8" +
+			"ERIKSSON<<ANNA<MARIA<<<<<<<<<<",
+	} {
+		R := []rune(PT)
+		if c, _ := mrzComposite(R); c != R[59] {
+			t.Fatal(PT)
+		}
+
+		CT, err := mrz.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		// only the document number and the check digits change
+		if len(CT) != len(PT) || CT[5:14] == PT[5:14] ||
+			CT[:5] != PT[:5] || CT[15:59] != PT[15:59] ||
+			CT[60:] != PT[60:] {
+			t.Fatal(CT)
+		}
+
+		n := strings.TrimRight(PT[5:14], "<")
+		if CT[5+len(n):14] != PT[5+len(n):14] ||
+			strings.ContainsRune(CT[5:5+len(n)], '<') {
+			t.Fatal(CT)
+		}
+
+		R = []rune(CT)
+		if c, _ := mrzCheck(R[5:14]); c != R[14] {
+			t.Fatal(CT)
+		}
+		if c, _ := mrzComposite(R); c != R[59] {
+			t.Fatal(CT)
+		}
+
+		out, err := mrz.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	// a bad composite check digit
+	if _, err := mrz.Encrypt("I<UTOD231458907<<<<<<<<<<<<<<<"+
+		"7408122F1204159UTO<<<<<<<<<<<7"+
+		"ERIKSSON<<ANNA<MARIA<<<<<<<<<<", nil); err == nil {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,111 @@
+package ubiq
+
+import (
+	"errors"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Normalization specifies transformations applied to the input of
+// an FF1 or FF3-1 context before its characters are looked up in the
+// alphabet. the values may be combined, except that NormalizeNFC and
+// NormalizeNFKC are mutually exclusive
+type Normalization int
+
+const NormalizeNone Normalization = 0
+
+const (
+	// canonical composition, e.g. "e" followed by a
+	// combining acute accent becomes "é"
+	NormalizeNFC Normalization = 1 << iota
+	// compatibility composition; in addition to NFC, e.g.
+	// full-width digits become ASCII digits and "ﬁ" becomes "fi"
+	NormalizeNFKC
+	// Unicode case folding, e.g. "A" becomes "a" and "ß" becomes "ss"
+	NormalizeFoldCase
+)
+
+// check that @n is a valid combination of normalizations and
+// that the alphabet @alpha is unaffected by them. if it weren't,
+// ciphertexts containing the affected characters would not be
+// decryptable, as they would be altered prior to decryption
+func (n Normalization) check(alpha []rune) error {
+	if n&^(NormalizeNFC|NormalizeNFKC|NormalizeFoldCase) != 0 ||
+		n&NormalizeNFC != 0 && n&NormalizeNFKC != 0 {
+		return errors.New("invalid normalization")
+	}
+
+	var form *norm.Form
+	if n&NormalizeNFC != 0 {
+		f := norm.NFC
+		form = &f
+	} else if n&NormalizeNFKC != 0 {
+		f := norm.NFKC
+		form = &f
+	}
+
+	for _, r := range alpha {
+		s := string(r)
+		if string(n.apply([]rune(s))) != s {
+			return errors.New("alphabet is not normalized")
+		}
+
+		// characters that combine with the characters
+		// before them would be altered by composition
+		if form != nil && !form.PropertiesString(s).BoundaryBefore() {
+			return errors.New("alphabet is not normalized")
+		}
+	}
+
+	return nil
+}
+
+// apply the normalizations to @X. when case folding is combined
+// with composition, the input is composed both before and after it
+// is folded: compatibility characters may decompose to characters
+// that fold (e.g. "𝐀" to "A"), and folding may produce characters
+// that compose (e.g. "ǰ" to "j" followed by a combining caron)
+func (n Normalization) apply(X []rune) []rune {
+	if n == NormalizeNone {
+		return X
+	}
+
+	var form *norm.Form
+	if n&NormalizeNFC != 0 {
+		f := norm.NFC
+		form = &f
+	} else if n&NormalizeNFKC != 0 {
+		f := norm.NFKC
+		form = &f
+	}
+
+	s := string(X)
+	if form != nil {
+		s = form.String(s)
+	}
+	if n&NormalizeFoldCase != 0 {
+		s = cases.Fold().String(s)
+		if form != nil {
+			s = form.String(s)
+		}
+	}
+
+	return []rune(s)
+}
+
+// normalize the input @X and check that each of the resulting
+// characters is in the alphabet. normalization may produce
+// characters that are not, e.g. folding "İ" produces "i" followed
+// by a combining dot, even if "İ" itself isn't in the alphabet
+func (this *ffx) prepare(X []rune) ([]rune, error) {
+	X = this.norm.apply(X)
+
+	for _, r := range X {
+		if this.alpha.PosOf(r) < 0 {
+			return nil, errors.New("invalid character in input")
+		}
+	}
+
+	return X, nil
+}
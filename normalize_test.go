@@ -0,0 +1,136 @@
+package ubiq
+
+import (
+	"testing"
+)
+
+func testNormalize(t *testing.T, radix int, args []interface{},
+	PT []string, out string) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, radix, args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var CT []string
+	for _, X := range PT {
+		Y, err := ff1.Encrypt(X, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		CT = append(CT, Y)
+	}
+
+	for i := range CT {
+		if CT[i] != CT[0] {
+			t.Fatal(CT)
+		}
+	}
+
+	X, err := ff1.Decrypt(CT[0], nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if X != out {
+		t.Fatal(X + " != " + out)
+	}
+}
+
+func TestNormalizeNFC(t *testing.T) {
+	alpha := "abcdefghijklmnopqrstuvwxyzéèêë"
+	testNormalize(t, 30,
+		[]interface{}{alpha, NormalizeNFC},
+		[]string{"ren\u00e9e", "rene\u0301e"}, "ren\u00e9e")
+}
+
+func TestNormalizeNFKC(t *testing.T) {
+	testNormalize(t, 10,
+		[]interface{}{NormalizeNFKC, DigitsAlphabet},
+		[]string{"１２３４５６７", "1234567", "12３4５67"}, "1234567")
+}
+
+func TestNormalizeFoldCase(t *testing.T) {
+	testNormalize(t, 36,
+		[]interface{}{NormalizeFoldCase | NormalizeNFC},
+		[]string{"Alice99", "ALICE99", "alice99"}, "alice99")
+}
+
+func TestNormalizeFoldCaseNFKC(t *testing.T) {
+	// compatibility characters are decomposed before folding,
+	// so those that decompose to upper case letters are folded
+	testNormalize(t, 26,
+		[]interface{}{LowerAlphabet, NormalizeNFKC | NormalizeFoldCase},
+		[]string{"abcdef𝐀", "abcdefA", "abcdefa"}, "abcdefa")
+	testNormalize(t, 26,
+		[]interface{}{LowerAlphabet, NormalizeNFKC | NormalizeFoldCase},
+		[]string{"ℌello", "Hello", "hello"}, "hello")
+}
+
+func TestNormalizeOutsideAlphabet(t *testing.T) {
+	for _, c := range []struct {
+		args  []interface{}
+		radix int
+		in    string
+	}{
+		// folding produces "i" followed by a combining dot
+		{[]interface{}{NormalizeFoldCase | NormalizeNFC}, 36, "İstanbul"},
+		{[]interface{}{NormalizeFoldCase}, 36, "İstanbul"},
+		// neither is changed by folding without nfkc
+		{[]interface{}{LowerAlphabet, NormalizeFoldCase | NormalizeNFC},
+			26, "abcdef𝐀"},
+		{[]interface{}{LowerAlphabet, NormalizeFoldCase | NormalizeNFC},
+			26, "ℌello"},
+		// characters outside the alphabet without normalization
+		{nil, 36, "abc-def"},
+		{[]interface{}{LowerAlphabet}, 26, "abcdefA"},
+	} {
+		ff1, err := NewFF1(testMixedKey, nil, 0, 0, c.radix, c.args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ff1.Encrypt(c.in, nil); err == nil {
+			t.Fatal(c.in)
+		}
+		if _, err := ff1.Decrypt(c.in, nil); err == nil {
+			t.Fatal(c.in)
+		}
+
+		ff3, err := NewFF3_1(testMixedKey, make([]byte, 7), c.radix,
+			c.args...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := ff3.Encrypt(c.in, nil); err == nil {
+			t.Fatal(c.in)
+		}
+	}
+}
+
+func TestNormalizeFingerprint(t *testing.T) {
+	if _, err := NewFF1(testMixedKey, nil, 0, 0, 10,
+		DigitsAlphabet, NormalizeNFKC,
+		DigitsAlphabet.Fingerprint()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestNormalizeInvalid(t *testing.T) {
+	for _, args := range [][]interface{}{
+		{NormalizeNFC | NormalizeNFKC},
+		{Normalization(0x100)},
+		// upper case letters are changed by case folding
+		{"ABCDEFGHIJ", NormalizeFoldCase},
+		// full-width digits are changed by nfkc
+		{"０１２３４５６７８９", NormalizeNFKC},
+		// a combining accent may compose with a preceding letter
+		{"́abcdefghi", NormalizeNFC},
+	} {
+		if _, err := NewFF1(testMixedKey, nil, 0, 0, 10,
+			args...); err == nil {
+			t.Fatal(args)
+		}
+		if _, err := NewFF3_1(testMixedKey, make([]byte, 7), 10,
+			args...); err == nil {
+			t.Fatal(args)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package ubiq
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// Context structure for encrypting payment card numbers (PANs)
+// such that the result remains a valid card number
+type PAN struct {
+	ff1 *FF1
+
+	// the number of leading and trailing digits left unencrypted
+	lead, trail int
+}
+
+// Allocate a new PAN context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// @lead and @trail are the number of digits at the beginning and
+// end of the card number that are left unencrypted. typically, the
+// issuer identification number (6 or 8 digits) and the last 4 digits
+// are left in the clear.
+//
+// the remaining digits are encrypted, except for one, which is
+// recomputed so that the result passes the Luhn check. this is the
+// check digit itself if @trail is 0, or the last encrypted digit
+// otherwise
+func NewPAN(ff1 *FF1, lead, trail int) (*PAN, error) {
+	if lead < 0 || trail < 0 {
+		return nil, errors.New("invalid number of preserved digits")
+	}
+
+	return &PAN{ff1: ff1, lead: lead, trail: trail}, nil
+}
+
+func (this *PAN) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	// positions of the digits within X. the card number may
+	// contain spaces or dashes, which are passed through
+	var pos []int
+	for i, r := range X {
+		switch {
+		case r >= '0' && r <= '9':
+			pos = append(pos, i)
+		case r == ' ' || r == '-':
+		default:
+			return nil, errors.New("invalid character in input")
+		}
+	}
+
+	if len(pos) < 12 || len(pos) > 19 {
+		return nil, errors.New("invalid card number length")
+	} else if this.lead+this.trail+2 > len(pos) {
+		return nil, errors.New("too few digits to encrypt")
+	}
+
+	D := make([]int, len(pos))
+	for i, p := range pos {
+		D[i] = int(X[p] - '0')
+	}
+
+	if luhnSum(D) != 0 {
+		return nil, errors.New("invalid card number check digit")
+	}
+
+	// the digits between @lead and @fix are encrypted,
+	// and the digit at @fix is recomputed
+	fix := len(D) - this.trail - 1
+
+	var b strings.Builder
+	for _, d := range D[this.lead:fix] {
+		b.WriteByte(byte('0' + d))
+	}
+
+	n, _ := new(big.Int).SetString(b.String(), 10)
+	m := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(fix-this.lead)), nil)
+
+	n, err := this.ff1.cycleWalk(n, m, T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	s := n.Text(10)
+	s = strings.Repeat("0", fix-this.lead-len(s)) + s
+	for i := range s {
+		D[this.lead+i] = int(s[i] - '0')
+	}
+
+	// choose the digit that makes the number valid
+	D[fix] = 0
+	for luhnSum(D) != 0 {
+		D[fix]++
+	}
+
+	Y := make([]rune, len(X))
+	copy(Y, X)
+	for i, p := range pos {
+		Y[p] = rune('0' + D[i])
+	}
+
+	return Y, nil
+}
+
+func (this *PAN) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt a card number @X with the tweak @T. the card number
+// must pass the Luhn check and may contain spaces or dashes,
+// which are preserved
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *PAN) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *PAN) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt a card number @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *PAN) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
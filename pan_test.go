@@ -0,0 +1,96 @@
+package ubiq
+
+import (
+	"testing"
+)
+
+func testPAN(t *testing.T, lead, trail int, inputs ...string) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pan, err := NewPAN(ff1, lead, trail)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range inputs {
+		CT, err := pan.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var D []int
+		for _, r := range CT {
+			if r >= '0' && r <= '9' {
+				D = append(D, int(r-'0'))
+			}
+		}
+		if luhnSum(D) != 0 {
+			t.Fatal(CT + " is not luhn-valid")
+		}
+
+		X, Y := []rune(PT), []rune(CT)
+		if len(X) != len(Y) {
+			t.Fatal(CT)
+		}
+
+		digits := 0
+		for i := range X {
+			if X[i] < '0' || X[i] > '9' {
+				if X[i] != Y[i] {
+					t.Fatal(CT)
+				}
+				continue
+			}
+			if (digits < lead || digits >= len(D)-trail) && X[i] != Y[i] {
+				t.Fatal(PT + " -> " + CT)
+			}
+			digits++
+		}
+
+		out, err := pan.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestPAN(t *testing.T) {
+	testPAN(t, 6, 4,
+		"4111111111111111",
+		"4111 1111 1111 1111",
+		"5500-0000-0000-0004",
+		"340000000000009",
+		"6011000990139424")
+}
+
+func TestPANNoTrailing(t *testing.T) {
+	testPAN(t, 0, 0,
+		"4111111111111111",
+		"378282246310005")
+}
+
+func TestPANInvalid(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	pan, _ := NewPAN(ff1, 6, 4)
+
+	for _, PT := range []string{
+		"4111111111111112", // bad check digit
+		"41111111111",      // too short
+		"4111x11111111111",
+	} {
+		if _, err := pan.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+
+	pan, _ = NewPAN(ff1, 8, 6)
+	if _, err := pan.Encrypt("4111111111111", nil); err == nil {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,142 @@
+package ubiq
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// country calling codes that are shorter than 3 digits. all other
+// codes assigned by the ITU are 3 digits long. because the codes
+// form a prefix code, the length of the code at the start of a
+// number can be determined by checking for 1 and 2 digit codes
+var phoneShortCodes = map[string]bool{
+	"1": true, "7": true,
+
+	"20": true, "27": true, "30": true, "31": true, "32": true,
+	"33": true, "34": true, "36": true, "39": true, "40": true,
+	"41": true, "43": true, "44": true, "45": true, "46": true,
+	"47": true, "48": true, "49": true, "51": true, "52": true,
+	"53": true, "54": true, "55": true, "56": true, "57": true,
+	"58": true, "60": true, "61": true, "62": true, "63": true,
+	"64": true, "65": true, "66": true, "81": true, "82": true,
+	"84": true, "86": true, "90": true, "91": true, "92": true,
+	"93": true, "94": true, "95": true, "98": true,
+}
+
+// determine the length of the country calling code
+// at the beginning of the string of digits @D
+func phoneCodeLen(D string) int {
+	for l := 1; l < 3; l++ {
+		if phoneShortCodes[D[:l]] {
+			return l
+		}
+	}
+	return 3
+}
+
+// Context structure for encrypting international (E.164) phone
+// numbers such that the country calling code is preserved
+type Phone struct {
+	ff1 *FF1
+
+	keep int
+}
+
+// Allocate a new phone number context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// the leading + and the country calling code are always preserved.
+// @keep specifies the number of additional digits following the
+// country code (e.g. the area code) that are also preserved. the
+// remaining digits are encrypted, and spaces, dashes, dots and
+// parentheses are passed through
+func NewPhone(ff1 *FF1, keep int) (*Phone, error) {
+	if keep < 0 {
+		return nil, errors.New("invalid number of preserved digits")
+	}
+
+	return &Phone{ff1: ff1, keep: keep}, nil
+}
+
+func (this *Phone) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	if len(X) < 1 || X[0] != '+' {
+		return nil, errors.New("phone number must begin with +")
+	}
+
+	var pos []int
+	var b strings.Builder
+	for i, r := range X[1:] {
+		switch {
+		case r >= '0' && r <= '9':
+			pos = append(pos, i+1)
+			b.WriteRune(r)
+		case strings.ContainsRune(" -.()", r):
+		default:
+			return nil, errors.New("invalid character in input")
+		}
+	}
+
+	D := b.String()
+	if len(D) < 4 || len(D) > 15 {
+		return nil, errors.New("invalid phone number length")
+	}
+
+	l := phoneCodeLen(D) + this.keep
+	if l >= len(D) {
+		return nil, errors.New("too few digits to encrypt")
+	}
+
+	n, _ := new(big.Int).SetString(D[l:], 10)
+	m := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(len(D)-l)), nil)
+
+	n, err := this.ff1.cycleWalk(n, m, T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	s := n.Text(10)
+	s = strings.Repeat("0", len(D)-l-len(s)) + s
+
+	Y := make([]rune, len(X))
+	copy(Y, X)
+	for i, c := range s {
+		Y[pos[l+i]] = c
+	}
+
+	return Y, nil
+}
+
+func (this *Phone) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt a phone number @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Phone) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *Phone) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt a phone number @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Phone) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
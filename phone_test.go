@@ -0,0 +1,99 @@
+package ubiq
+
+import (
+	"testing"
+)
+
+func testPhone(t *testing.T, keep int, inputs ...string) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	phone, err := NewPhone(ff1, keep)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range inputs {
+		CT, err := phone.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		X, Y := []rune(PT), []rune(CT)
+		if len(X) != len(Y) {
+			t.Fatal(CT)
+		}
+
+		var D string
+		for i := range X {
+			if X[i] >= '0' && X[i] <= '9' {
+				if Y[i] < '0' || Y[i] > '9' {
+					t.Fatal(CT)
+				}
+				D += string(X[i])
+			} else if X[i] != Y[i] {
+				t.Fatal(CT)
+			}
+		}
+
+		// the preserved prefix, including all formatting
+		l := phoneCodeLen(D) + keep
+		for i, d := 0, 0; d < l; i++ {
+			if X[i] != Y[i] {
+				t.Fatal(PT + " -> " + CT)
+			}
+			if X[i] >= '0' && X[i] <= '9' {
+				d++
+			}
+		}
+
+		out, err := phone.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestPhone(t *testing.T) {
+	testPhone(t, 0,
+		"+14155552671",
+		"+1 (415) 555-2671",
+		"+44 20 7946 0958",
+		"+353 1 234 5678",
+		"+7.495.123.45.67")
+}
+
+func TestPhoneAreaCode(t *testing.T) {
+	testPhone(t, 3,
+		"+1 (415) 555-2671",
+		"+49 030 1234567")
+}
+
+func TestPhoneCodeLen(t *testing.T) {
+	for D, l := range map[string]int{
+		"14155552671": 1, "74951234567": 1, "442079460958": 2,
+		"4930123456": 2, "35312345678": 3, "8801712345678": 3,
+	} {
+		if phoneCodeLen(D) != l {
+			t.Fatal(D)
+		}
+	}
+}
+
+func TestPhoneInvalid(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	phone, _ := NewPhone(ff1, 0)
+
+	for _, PT := range []string{
+		"14155552671", "+1 415 555 267x", "+1234567890123456", "+123",
+	} {
+		if _, err := phone.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
@@ -0,0 +1,702 @@
+package ubiq
+
+import (
+	"errors"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"golang.org/x/exp/slices"
+)
+
+// the largest repetition count allowed in a {n,m} quantifier
+const regexMaxRepeat = 1000
+
+// the largest number of states allowed in the nondeterministic
+// automaton compiled from an expression. each repetition is compiled
+// separately, so the counts of nested quantifiers multiply, and the
+// limit on individual counts alone does not bound the automaton
+const regexMaxStates = 1 << 16
+
+// node types for the parsed expression
+const (
+	reSet = iota
+	reConcat
+	reAlt
+	reRepeat
+)
+
+// a node in the parse tree of a regular expression
+type reNode struct {
+	op int
+
+	// the (sorted) characters matched by a reSet node
+	set []rune
+	// the children of reConcat and reAlt nodes and
+	// the single child of a reRepeat node
+	sub []*reNode
+	// the bounds of a reRepeat node; max is -1 if unbounded
+	min, max int
+}
+
+// a parser for the restricted regular expression syntax
+// supported by the Regex format:
+//   - literal characters, with \ escaping any metacharacter
+//   - character classes, e.g. [A-Z0-9_], without negation
+//   - \d (digits) and \w (letters, digits and underscore)
+//   - groups, both (...) and (?:...)
+//   - alternation with |
+//   - the quantifiers ?, *, +, {n}, {n,} and {n,m}
+type reParser struct {
+	s []rune
+	i int
+}
+
+func (this *reParser) more() bool {
+	return this.i < len(this.s)
+}
+
+func (this *reParser) peek() rune {
+	return this.s[this.i]
+}
+
+func (this *reParser) parseAlt() (*reNode, error) {
+	var alts []*reNode
+
+	for {
+		n, err := this.parseConcat()
+		if err != nil {
+			return nil, err
+		}
+		alts = append(alts, n)
+
+		if !this.more() || this.peek() != '|' {
+			break
+		}
+		this.i++
+	}
+
+	if len(alts) == 1 {
+		return alts[0], nil
+	}
+	return &reNode{op: reAlt, sub: alts}, nil
+}
+
+func (this *reParser) parseConcat() (*reNode, error) {
+	n := &reNode{op: reConcat}
+
+	for this.more() && this.peek() != '|' && this.peek() != ')' {
+		a, err := this.parseAtom()
+		if err != nil {
+			return nil, err
+		}
+
+		a, err = this.parseQuant(a)
+		if err != nil {
+			return nil, err
+		}
+
+		n.sub = append(n.sub, a)
+	}
+
+	return n, nil
+}
+
+func (this *reParser) parseAtom() (*reNode, error) {
+	c := this.peek()
+	this.i++
+
+	switch c {
+	case '(':
+		if this.i+1 < len(this.s) &&
+			this.s[this.i] == '?' && this.s[this.i+1] == ':' {
+			this.i += 2
+		}
+
+		n, err := this.parseAlt()
+		if err != nil {
+			return nil, err
+		}
+
+		if !this.more() || this.peek() != ')' {
+			return nil, errors.New("missing ) in expression")
+		}
+		this.i++
+
+		return n, nil
+	case '[':
+		return this.parseClass()
+	case '\\':
+		set, err := this.parseEscape()
+		if err != nil {
+			return nil, err
+		}
+		return &reNode{op: reSet, set: set}, nil
+	case '.', '^', '$':
+		return nil, errors.New(
+			"unsupported metacharacter in expression: " + string(c))
+	case '*', '+', '?', '{', ']', '}':
+		return nil, errors.New(
+			"unexpected metacharacter in expression: " + string(c))
+	}
+
+	return &reNode{op: reSet, set: []rune{c}}, nil
+}
+
+// parse the character(s) following a backslash
+func (this *reParser) parseEscape() ([]rune, error) {
+	if !this.more() {
+		return nil, errors.New("trailing \\ in expression")
+	}
+
+	c := this.peek()
+	this.i++
+
+	switch {
+	case c == 'd':
+		return []rune("0123456789"), nil
+	case c == 'w':
+		return []rune("0123456789" +
+			"ABCDEFGHIJKLMNOPQRSTUVWXYZ_" +
+			"abcdefghijklmnopqrstuvwxyz"), nil
+	case c < 0x80 &&
+		(c >= '0' && c <= '9' ||
+			c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'):
+		return nil, errors.New(
+			"unsupported escape in expression: \\" + string(c))
+	}
+
+	return []rune{c}, nil
+}
+
+func (this *reParser) parseClass() (*reNode, error) {
+	var set []rune
+
+	if this.more() && this.peek() == '^' {
+		return nil, errors.New("negated classes are not supported")
+	}
+
+	for {
+		if !this.more() {
+			return nil, errors.New("missing ] in expression")
+		}
+
+		c := this.peek()
+		this.i++
+
+		if c == ']' {
+			break
+		} else if c == '\\' {
+			esc, err := this.parseEscape()
+			if err != nil {
+				return nil, err
+			}
+			set = append(set, esc...)
+			continue
+		}
+
+		if this.i+1 < len(this.s) &&
+			this.s[this.i] == '-' && this.s[this.i+1] != ']' {
+			h := this.s[this.i+1]
+			if h < c {
+				return nil, errors.New("invalid range in class")
+			}
+			for r := c; r <= h; r++ {
+				set = append(set, r)
+			}
+			this.i += 2
+		} else {
+			set = append(set, c)
+		}
+	}
+
+	if len(set) == 0 {
+		return nil, errors.New("empty class in expression")
+	}
+
+	slices.Sort(set)
+	set = slices.Compact(set)
+
+	return &reNode{op: reSet, set: set}, nil
+}
+
+func (this *reParser) parseInt() (int, bool) {
+	j := this.i
+	for j < len(this.s) && this.s[j] >= '0' && this.s[j] <= '9' {
+		j++
+	}
+
+	v, err := strconv.Atoi(string(this.s[this.i:j]))
+	if err != nil {
+		return 0, false
+	}
+
+	this.i = j
+	return v, true
+}
+
+func (this *reParser) parseQuant(a *reNode) (*reNode, error) {
+	for this.more() {
+		min, max := 0, 0
+
+		switch this.peek() {
+		case '?':
+			min, max = 0, 1
+		case '*':
+			min, max = 0, -1
+		case '+':
+			min, max = 1, -1
+		case '{':
+			var ok bool
+
+			this.i++
+			if min, ok = this.parseInt(); !ok {
+				return nil, errors.New("invalid quantifier")
+			}
+
+			max = min
+			if this.more() && this.peek() == ',' {
+				this.i++
+				if this.more() && this.peek() == '}' {
+					max = -1
+				} else if max, ok = this.parseInt(); !ok {
+					return nil, errors.New("invalid quantifier")
+				}
+			}
+
+			if !this.more() || this.peek() != '}' {
+				return nil, errors.New("missing } in quantifier")
+			} else if min > regexMaxRepeat || max > regexMaxRepeat ||
+				(max >= 0 && max < min) {
+				return nil, errors.New("invalid quantifier")
+			}
+		default:
+			return a, nil
+		}
+
+		this.i++
+		a = &reNode{op: reRepeat, sub: []*reNode{a}, min: min, max: max}
+	}
+
+	return a, nil
+}
+
+// a state in a nondeterministic automaton. a state either
+// has epsilon transitions to the states in @eps or transitions
+// on any of the characters in @set to the state @next
+type nfaState struct {
+	eps  []int
+	set  []rune
+	next int
+}
+
+type nfa struct {
+	states []nfaState
+}
+
+func (this *nfa) add() int {
+	this.states = append(this.states, nfaState{})
+	return len(this.states) - 1
+}
+
+func (this *nfa) link(from, to int) {
+	this.states[from].eps = append(this.states[from].eps, to)
+}
+
+// compute the number of states that compile would add to the
+// automaton for the parse tree rooted at @n. the result saturates
+// at @limit + 1 so that large nested repetitions cannot overflow
+func (this *reNode) states(limit int) int {
+	sat := func(v int) int {
+		if v > limit {
+			return limit + 1
+		}
+		return v
+	}
+
+	switch this.op {
+	case reSet:
+		return 2
+	case reRepeat:
+		sub := this.sub[0].states(limit)
+
+		// the number of copies of the child
+		c := this.min
+		if this.max < 0 {
+			c++
+		} else {
+			c = this.max
+		}
+
+		// the start state and the state following any
+		// optional repetitions, plus the child copies
+		if c > 0 && sub > (limit+1)/c {
+			return limit + 1
+		}
+		return sat(2 + c*sub)
+	}
+
+	// alternation and concatenation
+	t := 2
+	for _, a := range this.sub {
+		t = sat(t + a.states(limit))
+	}
+	return t
+}
+
+// compile the parse tree rooted at @n into the automaton,
+// returning the start and end states of the resulting fragment
+func (this *nfa) compile(n *reNode) (int, int) {
+	switch n.op {
+	case reSet:
+		s, e := this.add(), this.add()
+		this.states[s].set = n.set
+		this.states[s].next = e
+		return s, e
+	case reAlt:
+		s, e := this.add(), this.add()
+		for _, a := range n.sub {
+			as, ae := this.compile(a)
+			this.link(s, as)
+			this.link(ae, e)
+		}
+		return s, e
+	case reRepeat:
+		s := this.add()
+		e := s
+
+		for i := 0; i < n.min; i++ {
+			as, ae := this.compile(n.sub[0])
+			this.link(e, as)
+			e = ae
+		}
+
+		if n.max < 0 {
+			l := this.add()
+			as, ae := this.compile(n.sub[0])
+			this.link(e, l)
+			this.link(l, as)
+			this.link(ae, l)
+			e = l
+		} else if n.max > n.min {
+			// each optional repetition may be skipped,
+			// skipping all of the subsequent ones as well
+			f := this.add()
+			for i := n.min; i < n.max; i++ {
+				as, ae := this.compile(n.sub[0])
+				this.link(e, as)
+				this.link(e, f)
+				e = ae
+			}
+			this.link(e, f)
+			e = f
+		}
+
+		return s, e
+	}
+
+	// concatenation
+	s := this.add()
+	e := s
+	for _, a := range n.sub {
+		as, ae := this.compile(a)
+		this.link(e, as)
+		e = ae
+	}
+	return s, e
+}
+
+// compute the (sorted) set of states reachable from
+// those in @set by following only epsilon transitions
+func (this *nfa) closure(set []int) []int {
+	seen := make(map[int]bool)
+	stack := append([]int{}, set...)
+
+	for len(stack) > 0 {
+		s := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		if seen[s] {
+			continue
+		}
+		seen[s] = true
+
+		stack = append(stack, this.states[s].eps...)
+	}
+
+	res := make([]int, 0, len(seen))
+	for s := range seen {
+		res = append(res, s)
+	}
+	slices.Sort(res)
+
+	return res
+}
+
+// a transition in a deterministic automaton
+type dfaEdge struct {
+	c    rune
+	next int
+}
+
+// a state in a deterministic automaton. the transitions
+// are sorted by character, which determines the order of
+// the strings in the language for the purposes of ranking
+type dfaState struct {
+	edges  []dfaEdge
+	accept bool
+}
+
+// Context structure for encrypting strings that match a regular
+// expression such that the output matches the same expression
+type Regex struct {
+	ff1 *FF1
+
+	states []dfaState
+	// cnt[l][s] is the number of strings of length l
+	// that are accepted starting from state s. the table
+	// is extended as longer inputs are encountered
+	cnt [][]*big.Int
+}
+
+// Allocate a new regular expression context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// @expr is the expression which inputs must match in their
+// entirety. the expression may contain literal characters,
+// character classes (e.g. [A-Z0-9], but not negated classes),
+// the \d and \w classes, groups, alternation, and the ?, *, +,
+// {n}, {n,} and {n,m} quantifiers. because the output is
+// always the same length as the input, unbounded quantifiers
+// are allowed. repetition counts are limited to 1000, and
+// expressions that are too large when compiled, such as those
+// with deeply nested counted quantifiers, are rejected.
+//
+// For example, the expression [A-Z]{1,2}[0-9]{1,4} would cause
+// "AB12" to be encrypted to another string of two letters followed
+// by two digits or one letter followed by three digits
+func NewRegex(ff1 *FF1, expr string) (*Regex, error) {
+	p := reParser{s: []rune(expr)}
+
+	root, err := p.parseAlt()
+	if err != nil {
+		return nil, err
+	} else if p.more() {
+		return nil, errors.New("unbalanced ) in expression")
+	}
+
+	if root.states(regexMaxStates) > regexMaxStates {
+		return nil, errors.New("expression is too complex")
+	}
+
+	var n nfa
+	start, end := n.compile(root)
+
+	this := new(Regex)
+	this.ff1 = ff1
+
+	// subset construction. each state of the deterministic
+	// automaton is a set of states of the nondeterministic one
+	index := make(map[string]int)
+	queue := [][]int{n.closure([]int{start})}
+
+	key := func(set []int) string {
+		var b strings.Builder
+		for _, s := range set {
+			b.WriteString(strconv.Itoa(s))
+			b.WriteByte(',')
+		}
+		return b.String()
+	}
+
+	index[key(queue[0])] = 0
+	this.states = append(this.states, dfaState{})
+
+	for i := 0; i < len(queue); i++ {
+		set := queue[i]
+
+		var chars []rune
+		for _, s := range set {
+			chars = append(chars, n.states[s].set...)
+			if s == end {
+				this.states[i].accept = true
+			}
+		}
+		slices.Sort(chars)
+		chars = slices.Compact(chars)
+
+		for _, c := range chars {
+			var next []int
+			for _, s := range set {
+				if _, ok := slices.BinarySearch(n.states[s].set, c); ok {
+					next = append(next, n.states[s].next)
+				}
+			}
+			next = n.closure(next)
+
+			k := key(next)
+			j, ok := index[k]
+			if !ok {
+				j = len(queue)
+				index[k] = j
+				queue = append(queue, next)
+				this.states = append(this.states, dfaState{})
+			}
+
+			this.states[i].edges = append(this.states[i].edges,
+				dfaEdge{c: c, next: j})
+		}
+	}
+
+	this.cnt = make([][]*big.Int, 1)
+	this.cnt[0] = make([]*big.Int, len(this.states))
+	for i := range this.states {
+		this.cnt[0][i] = big.NewInt(0)
+		if this.states[i].accept {
+			this.cnt[0][i].SetInt64(1)
+		}
+	}
+
+	return this, nil
+}
+
+// return the table of counts of accepted strings
+// of length @l from each state
+func (this *Regex) count(l int) []*big.Int {
+	for len(this.cnt) <= l {
+		prev := this.cnt[len(this.cnt)-1]
+		cur := make([]*big.Int, len(this.states))
+
+		for i := range this.states {
+			cur[i] = big.NewInt(0)
+			for _, e := range this.states[i].edges {
+				cur[i].Add(cur[i], prev[e.next])
+			}
+		}
+
+		this.cnt = append(this.cnt, cur)
+	}
+
+	return this.cnt[l]
+}
+
+// Count returns the number of strings of length @l
+// matching the expression
+func (this *Regex) Count(l int) *big.Int {
+	return new(big.Int).Set(this.count(l)[0])
+}
+
+// find the transition from state @s on character @c
+func (this *Regex) edge(s int, c rune) (int, bool) {
+	return slices.BinarySearchFunc(this.states[s].edges, c,
+		func(e dfaEdge, c rune) int {
+			return int(e.c) - int(c)
+		})
+}
+
+// determine the position of @X among all of the strings of
+// the same length that match the expression
+func (this *Regex) rank(X []rune) (*big.Int, error) {
+	n := big.NewInt(0)
+	s := 0
+
+	for i, c := range X {
+		cnt := this.count(len(X) - i - 1)
+
+		j, ok := this.edge(s, c)
+		if !ok {
+			return nil, errors.New("input does not match expression")
+		}
+
+		for _, e := range this.states[s].edges[:j] {
+			n.Add(n, cnt[e.next])
+		}
+
+		s = this.states[s].edges[j].next
+	}
+
+	if !this.states[s].accept {
+		return nil, errors.New("input does not match expression")
+	}
+
+	return n, nil
+}
+
+// produce the string of length @l at position @n among all
+// of the strings of that length that match the expression;
+// this is the inverse of rank()
+func (this *Regex) unrank(n *big.Int, l int) []rune {
+	X := make([]rune, l)
+	r := new(big.Int).Set(n)
+	s := 0
+
+	for i := range X {
+		cnt := this.count(l - i - 1)
+
+		for _, e := range this.states[s].edges {
+			if r.Cmp(cnt[e.next]) < 0 {
+				X[i] = e.c
+				s = e.next
+				break
+			}
+			r.Sub(r, cnt[e.next])
+		}
+	}
+
+	return X
+}
+
+func (this *Regex) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	n, err := this.rank(X)
+	if err != nil {
+		return nil, err
+	}
+
+	m := this.count(len(X))[0]
+
+	// a single possible value can't be hidden
+	if m.Cmp(big.NewInt(1)) == 0 {
+		return append([]rune{}, X...), nil
+	}
+
+	n, err = this.ff1.cycleWalk(n, m, T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	return this.unrank(n, len(X)), nil
+}
+
+func (this *Regex) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt a string @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Regex) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *Regex) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt a string @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *Regex) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
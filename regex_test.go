@@ -0,0 +1,152 @@
+package ubiq
+
+import (
+	"math/big"
+	"regexp"
+	"testing"
+)
+
+func testRegex(t *testing.T, expr string, inputs ...string) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	re, err := NewRegex(ff1, expr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	std := regexp.MustCompile("^(?:" + expr + ")$")
+
+	for _, PT := range inputs {
+		CT, err := re.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len([]rune(CT)) != len([]rune(PT)) || !std.MatchString(CT) {
+			t.Fatal(CT + " does not match " + expr)
+		}
+
+		out, err := re.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestRegexVariable(t *testing.T) {
+	testRegex(t, "[A-Z]{1,2}[0-9]{1,4}",
+		"A1", "AB1", "A123", "AB12", "ZZ9999", "A9999")
+}
+
+func TestRegexAlternation(t *testing.T) {
+	testRegex(t, `(?:\d{3}-\d{4}|[a-f]+x)`,
+		"555-1234", "abcdefx", "abcfx")
+}
+
+func TestRegexUnbounded(t *testing.T) {
+	testRegex(t, `\w+@(ab|cd)\.com`,
+		"john_smith@ab.com", "X@cd.com")
+}
+
+func TestRegexRank(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	re, err := NewRegex(ff1, "[ab]{1,2}c?")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// length 2: aa, ab, ac, ba, bb, bc
+	if re.Count(2).Cmp(big.NewInt(6)) != 0 {
+		t.Fatal(re.Count(2))
+	}
+
+	for i, s := range []string{"aa", "ab", "ac", "ba", "bb", "bc"} {
+		n, err := re.rank([]rune(s))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if n.Int64() != int64(i) {
+			t.Fatal(s, n)
+		}
+		if out := string(re.unrank(n, 2)); out != s {
+			t.Fatal(out + " != " + s)
+		}
+	}
+
+	if _, err := re.rank([]rune("ca")); err == nil {
+		t.FailNow()
+	}
+}
+
+func TestRegexSingleMatch(t *testing.T) {
+	// only one string of each length matches,
+	// so the input is returned unchanged
+	testRegex(t, "(ab)*", "ab", "abab")
+	testRegex(t, "literal", "literal")
+}
+
+func TestRegexMismatch(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	re, err := NewRegex(ff1, "[A-Z]{1,2}[0-9]{1,4}")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{"", "1A", "ABC1", "A12345", "a1"} {
+		if _, err := re.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
+
+func TestRegexBadExpr(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	for _, expr := range []string{
+		"(ab", "ab)", "[ab", "[^ab]", "a{2,1}", "a.b", "*a", `\q`,
+	} {
+		if _, err := NewRegex(ff1, expr); err == nil {
+			t.Fatal(expr)
+		}
+	}
+}
+
+func TestRegexTooComplex(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	// nested quantifiers multiply the size of the automaton
+	for _, expr := range []string{
+		"(a{1000}){300}", "((a{1000}){1000}){1000}", "((a|b){1000,}){100}",
+	} {
+		if _, err := NewRegex(ff1, expr); err == nil {
+			t.Fatal(expr)
+		}
+	}
+
+	// the computed size is an upper bound on the actual size
+	for _, expr := range []string{
+		"a", "(ab|c)*d", "[A-Z]{1,2}[0-9]{1,4}", "((ab)?c{2,5}){3,}",
+		`(?:\d{3}-\d{4}|[a-f]+x)`, "(a{100}){100}",
+	} {
+		p := reParser{s: []rune(expr)}
+		root, err := p.parseAlt()
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		var n nfa
+		n.compile(root)
+		if c := root.states(regexMaxStates); c < len(n.states) ||
+			c > regexMaxStates {
+			t.Fatal(expr, c, len(n.states))
+		}
+	}
+}
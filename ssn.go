@@ -0,0 +1,123 @@
+package ubiq
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// Context structure for encrypting US Social Security Numbers
+// such that the result is a number that could have been issued
+type SSN struct {
+	ff1 *FF1
+}
+
+// Allocate a new SSN context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// the area number (the first three digits) of a valid SSN is
+// never 000, 666, or 900 through 999; the group number (the next
+// two digits) is never 00; and the serial number (the last four
+// digits) is never 0000. both the input and output must satisfy
+// these rules, which is achieved by cycle-walking through the
+// numbers that don't
+func NewSSN(ff1 *FF1) (*SSN, error) {
+	return &SSN{ff1: ff1}, nil
+}
+
+// determine whether the 9 digit number @n is a valid SSN
+func ssnValid(n int64) bool {
+	area := n / 1000000
+	group := (n / 10000) % 100
+	serial := n % 10000
+
+	return area != 0 && area != 666 && area < 900 &&
+		group != 0 && serial != 0
+}
+
+func (this *SSN) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	var dashed bool
+
+	switch len(X) {
+	case 9:
+	case 11:
+		if X[3] != '-' || X[6] != '-' {
+			return nil, errors.New("invalid character in input")
+		}
+		dashed = true
+	default:
+		return nil, errors.New("invalid text length")
+	}
+
+	var n int64
+	for i, r := range X {
+		if dashed && (i == 3 || i == 6) {
+			continue
+		} else if r < '0' || r > '9' {
+			return nil, errors.New("invalid character in input")
+		}
+		n = n*10 + int64(r-'0')
+	}
+
+	if !ssnValid(n) {
+		return nil, errors.New("invalid social security number")
+	}
+
+	m := big.NewInt(1000000000)
+	y := big.NewInt(n)
+	for {
+		var err error
+
+		y, err = this.ff1.cycleWalk(y, m, T, enc)
+		if err != nil {
+			return nil, err
+		}
+
+		if ssnValid(y.Int64()) {
+			break
+		}
+	}
+
+	n = y.Int64()
+	if dashed {
+		return []rune(fmt.Sprintf("%03d-%02d-%04d",
+			n/1000000, (n/10000)%100, n%10000)), nil
+	}
+	return []rune(fmt.Sprintf("%09d", n)), nil
+}
+
+func (this *SSN) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt a social security number @X with the tweak @T. the
+// number may be formatted as 123-45-6789 or as 123456789, and
+// the output is formatted in the same way
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *SSN) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *SSN) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt a social security number @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *SSN) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
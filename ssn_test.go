@@ -0,0 +1,69 @@
+package ubiq
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSSN(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ssn, err := NewSSN(ff1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 200; i++ {
+		n := int64(100000000 + i*3999997)
+		if !ssnValid(n) {
+			continue
+		}
+
+		PT := strconv.FormatInt(n, 10)
+		if i%2 == 0 {
+			PT = PT[:3] + "-" + PT[3:5] + "-" + PT[5:]
+		}
+
+		CT, err := ssn.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(PT, err)
+		}
+
+		if len(CT) != len(PT) ||
+			strings.Count(CT, "-") != strings.Count(PT, "-") {
+			t.Fatal(CT)
+		}
+
+		n, _ = strconv.ParseInt(strings.ReplaceAll(CT, "-", ""), 10, 64)
+		if !ssnValid(n) {
+			t.Fatal(CT + " is not a valid SSN")
+		}
+
+		out, err := ssn.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestSSNInvalid(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	ssn, _ := NewSSN(ff1)
+
+	for _, PT := range []string{
+		"000-12-3456", "666-12-3456", "900-12-3456",
+		"123-00-4567", "123-45-0000", "123-456789",
+		"12345678", "12a456789",
+	} {
+		if _, err := ssn.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
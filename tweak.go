@@ -0,0 +1,96 @@
+package ubiq
+
+import (
+	"encoding/binary"
+)
+
+// Builder for tweaks composed of multiple fields, such as a table
+// name, a column name, and a tenant identifier. the fields are
+// encoded such that different sequences of fields always produce
+// different tweaks, e.g. "ab" followed by "c" is distinct from "a"
+// followed by "bc"
+type TweakBuilder struct {
+	fields [][]byte
+}
+
+// Allocate a new, empty tweak builder
+func NewTweakBuilder() *TweakBuilder {
+	return new(TweakBuilder)
+}
+
+// Add the bytes @f as the next field of the tweak
+func (this *TweakBuilder) Add(f []byte) *TweakBuilder {
+	this.fields = append(this.fields, append([]byte{}, f...))
+	return this
+}
+
+// Add the string @s as the next field of the tweak
+func (this *TweakBuilder) AddString(s string) *TweakBuilder {
+	return this.Add([]byte(s))
+}
+
+// return the canonical encoding of the fields: the number of
+// fields followed by each field, preceded by its length. all
+// counts and lengths are encoded as unsigned varints
+func (this *TweakBuilder) Bytes() []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+
+	T := append([]byte{},
+		buf[:binary.PutUvarint(buf, uint64(len(this.fields)))]...)
+	for _, f := range this.fields {
+		T = append(T, buf[:binary.PutUvarint(buf, uint64(len(f)))]...)
+		T = append(T, f...)
+	}
+
+	return T
+}
+
+// compute a 16-byte keyed hash of @s. the hash is an aes-cbc-mac of
+// a header block (identifying the purpose and containing the length
+// of the input) followed by the input, padded with zeros
+func (this *ffx) tweakHash(s []byte) []byte {
+	P := make([]byte, 16+((len(s)+15)/16)*16)
+	copy(P, "tweak")
+	binary.BigEndian.PutUint64(P[8:16], uint64(len(s)))
+	copy(P[16:], s)
+
+	h := make([]byte, 16)
+	this.prf(h, P)
+
+	return h
+}
+
+// Produce a tweak suitable for the context @ff1
+//
+// the canonical encoding of the fields is used as is if it
+// fits within the maximum tweak length of the context. if it
+// doesn't, it is replaced by a keyed hash, which is truncated
+// to the maximum length if that is less than 16 bytes. in either
+// case, the result is then padded with zeros to the minimum
+// tweak length of the context, if necessary
+func (this *TweakBuilder) ForFF1(ff1 *FF1) []byte {
+	ctx := ff1.ctx
+
+	T := this.Bytes()
+	if ctx.len.twk.max > 0 && len(T) > ctx.len.twk.max {
+		T = ctx.tweakHash(T)
+		if len(T) > ctx.len.twk.max {
+			T = T[:ctx.len.twk.max]
+		}
+	}
+
+	if len(T) < ctx.len.twk.min {
+		T = append(T, make([]byte, ctx.len.twk.min-len(T))...)
+	}
+
+	return T
+}
+
+// Produce a tweak suitable for the context @ff3_1
+//
+// as FF3-1 requires tweaks of exactly 7 bytes, the tweak is
+// always the first 7 bytes of a keyed hash of the canonical
+// encoding of the fields
+func (this *TweakBuilder) ForFF3_1(ff3_1 *FF3_1) []byte {
+	return ff3_1.ctx.tweakHash(this.Bytes())[:7]
+}
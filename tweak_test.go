@@ -0,0 +1,104 @@
+package ubiq
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestTweakBuilder(t *testing.T) {
+	a := NewTweakBuilder().AddString("ab").AddString("c").Bytes()
+	b := NewTweakBuilder().AddString("a").AddString("bc").Bytes()
+	c := NewTweakBuilder().AddString("abc").Bytes()
+	d := NewTweakBuilder().AddString("abc").AddString("").Bytes()
+
+	if !bytes.Equal(a, []byte{2, 2, 'a', 'b', 1, 'c'}) {
+		t.Fatal(a)
+	}
+
+	for i, x := range [][]byte{a, b, c, d} {
+		for j, y := range [][]byte{a, b, c, d} {
+			if i != j && bytes.Equal(x, y) {
+				t.Fatal(x, y)
+			}
+		}
+	}
+
+	if !bytes.Equal(NewTweakBuilder().Bytes(), []byte{0}) {
+		t.Fatal("empty tweak")
+	}
+
+	// fields are copied when they are added
+	f := []byte("tenant")
+	tb := NewTweakBuilder().Add(f)
+	f[0] = 'T'
+	if !bytes.Equal(tb.Bytes(), []byte("\x01\x06tenant")) {
+		t.Fatal(tb.Bytes())
+	}
+}
+
+func TestTweakBuilderFF1(t *testing.T) {
+	tb := NewTweakBuilder().
+		AddString("customers").AddString("email").AddString("tenant-42")
+	raw := tb.Bytes()
+
+	for _, c := range []struct {
+		min, max, len int
+	}{
+		{0, 0, len(raw)},
+		{0, 64, len(raw)},
+		{32, 64, 32},
+		{0, 16, 16},
+		{4, 8, 8},
+		{20, 24, 20},
+	} {
+		ff1, err := NewFF1(testMixedKey, make([]byte, c.min),
+			c.min, c.max, 10)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		T := tb.ForFF1(ff1)
+		if len(T) != c.len {
+			t.Fatal(c, len(T))
+		}
+		if len(raw) <= c.max && !bytes.Equal(T[:len(raw)], raw) {
+			t.Fatal(c, T)
+		}
+
+		CT, err := ff1.Encrypt("0123456789", T)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if PT, err := ff1.Decrypt(CT, T); err != nil || PT != "0123456789" {
+			t.Fatal(PT, err)
+		}
+	}
+
+	// the hash depends on the key
+	ff1a, _ := NewFF1(testMixedKey, nil, 0, 16, 10)
+	ff1b, _ := NewFF1(make([]byte, 16), nil, 0, 16, 10)
+	if bytes.Equal(tb.ForFF1(ff1a), tb.ForFF1(ff1b)) {
+		t.Fatal("keyed hash is independent of the key")
+	}
+}
+
+func TestTweakBuilderFF3_1(t *testing.T) {
+	ff3_1, err := NewFF3_1(testMixedKey, make([]byte, 7), 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	a := NewTweakBuilder().AddString("ab").AddString("c").ForFF3_1(ff3_1)
+	b := NewTweakBuilder().AddString("a").AddString("bc").ForFF3_1(ff3_1)
+	if len(a) != 7 || len(b) != 7 || bytes.Equal(a, b) {
+		t.Fatal(a, b)
+	}
+
+	CT, err := ff3_1.Encrypt("0123456789", a)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if PT, err := ff3_1.Decrypt(CT, a); err != nil || PT != "0123456789" {
+		t.Fatal(PT, err)
+	}
+}
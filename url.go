@@ -0,0 +1,297 @@
+package ubiq
+
+import (
+	"errors"
+	"net/netip"
+	"strings"
+	"unicode"
+)
+
+// the characters allowed at the beginning and end of a dns label
+// and those allowed in between (letters, digits, and hyphens)
+var (
+	dnsEdgeAlphabet, _ = NewAlphabet("0123456789abcdefghijklmnopqrstuvwxyz")
+	dnsLDHAlphabet, _  = NewAlphabet("0123456789abcdefghijklmnopqrstuvwxyz-")
+)
+
+// Context structure for encrypting host names and URLs such
+// that the results retain their structure and remain valid
+type URL struct {
+	ff1 *FF1
+	cp  *ClassPreserving
+
+	path, query bool
+}
+
+// Allocate a new URL context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used, and, as with
+// the ClassPreserving context, it should allow tweaks of arbitrary
+// length
+//
+// host names are encrypted such that the top-level domain, the
+// number of labels, and the length of each label are preserved.
+// each label is encrypted independently of the labels to its left,
+// so that subdomains of the same domain still share it after
+// encryption, and each consists of letters, digits and hyphens,
+// never beginning or ending with a hyphen. host
+// names are case-insensitive and are output in lower case. hosts
+// that are IP addresses and hosts consisting of a single label are
+// left unchanged. host names containing characters other than ASCII
+// letters, digits, hyphens and dots (including internationalized
+// names not in their ASCII form) are rejected.
+//
+// within URLs, the scheme, user information, port and fragment are
+// left unchanged. if @path is true, the path segments are encrypted
+// such that each character retains its class; if @query is true,
+// the values (but not the names) of query parameters are encrypted
+// in the same way. percent-encoded characters are left unchanged
+func NewURL(ff1 *FF1, path, query bool) (*URL, error) {
+	cp, err := NewClassPreserving(ff1)
+	if err != nil {
+		return nil, err
+	}
+
+	return &URL{ff1: ff1, cp: cp, path: path, query: query}, nil
+}
+
+// return a copy of the tweak @T (or the default tweak)
+// with @c appended to distinguish the parts of a URL
+func (this *URL) tweak(T []byte, c byte) []byte {
+	if T == nil {
+		T = this.ff1.ctx.twk
+	}
+
+	twk := make([]byte, len(T)+1)
+	copy(twk, T)
+	twk[len(T)] = c
+
+	return twk
+}
+
+func (this *URL) cipherHost(host string, T []byte, enc bool) (
+	string, error) {
+	if _, err := netip.ParseAddr(host); err == nil {
+		return host, nil
+	}
+
+	// only ASCII letters, digits, hyphens and dots are allowed,
+	// which also ensures that converting the name to lower case
+	// does not change its length
+	for _, r := range host {
+		if r >= 0x80 ||
+			(r != '.' && dnsLDHAlphabet.PosOf(unicode.ToLower(r)) < 0) {
+			return "", errors.New("invalid host name")
+		}
+	}
+
+	// a fully-qualified name may end with a dot
+	h, dot := strings.ToLower(host), ""
+	if strings.HasSuffix(host, ".") {
+		h, dot = h[:len(h)-1], "."
+	}
+
+	labels := strings.Split(h, ".")
+	if len(labels) < 2 {
+		return host, nil
+	}
+
+	if T == nil {
+		T = this.ff1.ctx.twk
+	}
+
+	// the labels preceding the top-level domain are encrypted from
+	// right to left, each with a tweak containing the (plaintext)
+	// domain to its right, so that subdomains of a domain share its
+	// encryption. this is the same scheme as that of the Email context
+	P := "." + labels[len(labels)-1]
+	for i := len(labels) - 2; i >= 0; i-- {
+		l := labels[i]
+		if len(l) < 1 || len(l) > 63 {
+			return "", errors.New("invalid host name")
+		}
+
+		var args []interface{}
+		for j := range l {
+			if j == 0 || j == len(l)-1 {
+				args = append(args, dnsEdgeAlphabet)
+			} else {
+				args = append(args, dnsLDHAlphabet)
+			}
+		}
+
+		mr, err := NewMixedRadix(this.ff1, args...)
+		if err != nil {
+			return "", err
+		}
+
+		X, err := mr.cipher([]rune(l), domainTweak(T, 'H', P), enc)
+		if err != nil {
+			return "", errors.New("invalid host name")
+		}
+
+		labels[i] = string(X)
+		if enc {
+			P = "." + l + P
+		} else {
+			P = "." + labels[i] + P
+		}
+	}
+
+	return strings.Join(labels, ".") + dot, nil
+}
+
+// encrypt the string @s such that each character retains its
+// class, leaving percent-encoded characters unchanged
+func (this *URL) cipherText(s string, T []byte, enc bool) (string, error) {
+	X := []rune(s)
+
+	var pos []int
+	for i := 0; i < len(X); i++ {
+		if X[i] == '%' {
+			i += 2
+			continue
+		}
+		pos = append(pos, i)
+	}
+
+	R := make([]rune, len(pos))
+	for i, p := range pos {
+		R[i] = X[p]
+	}
+
+	R, err := this.cp.cipher(R, T, enc)
+	if err != nil {
+		return "", err
+	}
+
+	for i, p := range pos {
+		X[p] = R[i]
+	}
+
+	return string(X), nil
+}
+
+func (this *URL) cipherQuery(q string, T []byte, enc bool) (string, error) {
+	params := strings.Split(q, "&")
+
+	// the values are encrypted together, separated
+	// by a character that is passed through
+	var vals []string
+	for _, p := range params {
+		if _, v, ok := strings.Cut(p, "="); ok {
+			vals = append(vals, v)
+		}
+	}
+
+	s, err := this.cipherText(strings.Join(vals, "&"), T, enc)
+	if err != nil {
+		return "", err
+	}
+	vals = strings.Split(s, "&")
+
+	for i, j := 0, 0; i < len(params); i++ {
+		if k, _, ok := strings.Cut(params[i], "="); ok {
+			params[i] = k + "=" + vals[j]
+			j++
+		}
+	}
+
+	return strings.Join(params, "&"), nil
+}
+
+func (this *URL) cipher(X string, T []byte, enc bool) (string, error) {
+	i := strings.Index(X, "://")
+	if i < 1 {
+		return "", errors.New("invalid url")
+	}
+
+	scheme, rest := X[:i+3], X[i+3:]
+
+	var frag string
+	if i := strings.IndexByte(rest, '#'); i >= 0 {
+		rest, frag = rest[:i], rest[i:]
+	}
+
+	var query string
+	q := strings.IndexByte(rest, '?')
+	if q >= 0 {
+		rest, query = rest[:q], rest[q+1:]
+	}
+
+	auth, path := rest, ""
+	if i := strings.IndexByte(rest, '/'); i >= 0 {
+		auth, path = rest[:i], rest[i:]
+	}
+
+	var user, port string
+	if i := strings.LastIndexByte(auth, '@'); i >= 0 {
+		user, auth = auth[:i+1], auth[i+1:]
+	}
+	if !strings.HasPrefix(auth, "[") {
+		if i := strings.LastIndexByte(auth, ':'); i >= 0 {
+			auth, port = auth[:i], auth[i:]
+		}
+	}
+
+	var err error
+
+	if auth != "" && !strings.HasPrefix(auth, "[") {
+		if auth, err = this.cipherHost(auth, T, enc); err != nil {
+			return "", err
+		}
+	}
+
+	if this.path && path != "" {
+		path, err = this.cipherText(path, this.tweak(T, 'P'), enc)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if this.query && query != "" {
+		query, err = this.cipherQuery(query, this.tweak(T, 'Q'), enc)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if q >= 0 {
+		query = "?" + query
+	}
+
+	return scheme + user + auth + port + path + query + frag, nil
+}
+
+// Encrypt the host name @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *URL) EncryptHost(X string, T []byte) (string, error) {
+	return this.cipherHost(X, T, true)
+}
+
+// Decrypt the host name @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *URL) DecryptHost(X string, T []byte) (string, error) {
+	return this.cipherHost(X, T, false)
+}
+
+// Encrypt the URL @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *URL) Encrypt(X string, T []byte) (string, error) {
+	return this.cipher(X, T, true)
+}
+
+// Decrypt the URL @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *URL) Decrypt(X string, T []byte) (string, error) {
+	return this.cipher(X, T, false)
+}
@@ -0,0 +1,151 @@
+package ubiq
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestURLHost(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	u, err := NewURL(ff1, false, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"www.example.com",
+		"mail-01.corp.example.co.uk",
+		"a-b.example.org.",
+		"x1.io",
+	} {
+		CT, err := u.EncryptHost(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pl, cl := strings.Split(PT, "."), strings.Split(CT, ".")
+		if len(pl) != len(cl) || pl[len(pl)-1] != cl[len(cl)-1] {
+			t.Fatal(CT)
+		}
+		for i := range cl {
+			if len(cl[i]) != len(pl[i]) ||
+				strings.HasPrefix(cl[i], "-") ||
+				strings.HasSuffix(cl[i], "-") {
+				t.Fatal(CT)
+			}
+			for _, r := range cl[i] {
+				if dnsLDHAlphabet.PosOf(r) < 0 {
+					t.Fatal(CT)
+				}
+			}
+		}
+
+		out, err := u.DecryptHost(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	for _, PT := range []string{"localhost", "192.168.1.1", "::1"} {
+		if CT, err := u.EncryptHost(PT, nil); err != nil || CT != PT {
+			t.Fatal(PT)
+		}
+	}
+
+	for _, PT := range []string{"-a.example.com", "a_b.example.com",
+		"a..example.com", "Ⱥ.com", "İİİİ.com", "bücher.example.com",
+		"\u212a.example.com"} {
+		if _, err := u.EncryptHost(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
+
+func TestURLHostParent(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	u, _ := NewURL(ff1, false, false)
+
+	p, _ := u.EncryptHost("example.com", nil)
+
+	// hosts sharing a parent domain still share one
+	for _, PT := range []string{
+		"a.example.com", "b.example.com", "www.example.com",
+		"mail-01.corp.example.com",
+	} {
+		CT, err := u.EncryptHost(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasSuffix(CT, "."+p) || p == "example.com" {
+			t.Fatal(p, CT)
+		}
+	}
+
+	a, _ := u.EncryptHost("a.example.com", nil)
+	b, _ := u.EncryptHost("b.example.com", nil)
+	if a == b {
+		t.Fatal(a)
+	}
+}
+
+func TestURL(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+
+	u, err := NewURL(ff1, true, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"https://www.example.com/",
+		"https://user@shop.example.com:8443/orders/12345/items?id=987&name=John%20Smith&flag#top",
+		"http://example.com/a/b?",
+		"http://[2001:db8::1]/status?code=200",
+		"ftp://files.example.net/pub/README.txt",
+	} {
+		CT, err := u.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		pu, _ := url.Parse(PT)
+		cu, err := url.Parse(CT)
+		if err != nil {
+			t.Fatal(CT, err)
+		}
+
+		if cu.Scheme != pu.Scheme || cu.Port() != pu.Port() ||
+			cu.User.String() != pu.User.String() ||
+			cu.Fragment != pu.Fragment ||
+			len(cu.Path) != len(pu.Path) ||
+			strings.Count(cu.Path, "/") != strings.Count(pu.Path, "/") ||
+			len(cu.Query()) != len(pu.Query()) {
+			t.Fatal(CT)
+		}
+		for k := range pu.Query() {
+			if _, ok := cu.Query()[k]; !ok {
+				t.Fatal(CT)
+			}
+		}
+
+		out, err := u.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	if _, err := u.Encrypt("www.example.com/path", nil); err == nil {
+		t.FailNow()
+	}
+}
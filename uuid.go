@@ -0,0 +1,107 @@
+package ubiq
+
+import (
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+// Context structure for encrypting UUIDs such that the result
+// is a UUID with the same version and variant
+type UUID struct {
+	ff1 *FF1
+}
+
+// Allocate a new UUID context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// UUIDs must be in the canonical textual form, e.g.
+// 123e4567-e89b-42d3-a456-426614174000, and of the RFC 4122
+// variant. of the 128 bits, the 4 version bits and 2 variant bits
+// are preserved and the remaining 122 bits are encrypted. the case
+// of the hexadecimal digits is also preserved
+func NewUUID(ff1 *FF1) (*UUID, error) {
+	return &UUID{ff1: ff1}, nil
+}
+
+// the layout of the free bits within a uuid, from most to least
+// significant: 48 bits of the time (or random) field, the 12 bits
+// following the version, and the 62 bits following the variant
+var uuidFields = [...]struct{ shift, bits uint }{
+	{80, 48}, {64, 12}, {0, 62},
+}
+
+func (this *UUID) cipher(X string, T []byte, enc bool) (string, error) {
+	if len(X) != 36 ||
+		X[8] != '-' || X[13] != '-' || X[18] != '-' || X[23] != '-' {
+		return "", errors.New("invalid uuid")
+	}
+
+	b, err := hex.DecodeString(
+		X[0:8] + X[9:13] + X[14:18] + X[19:23] + X[24:36])
+	if err != nil {
+		return "", errors.New("invalid uuid")
+	} else if b[8]&0xc0 != 0x80 {
+		return "", errors.New("unsupported uuid variant")
+	}
+
+	u := new(big.Int).SetBytes(b)
+
+	// gather the free bits into a single integer
+	n := big.NewInt(0)
+	t := big.NewInt(0)
+	for _, f := range uuidFields {
+		n.Lsh(n, f.bits)
+		t.Rsh(u, f.shift)
+		n.Or(n, t.And(t, bitMask(f.bits)))
+	}
+
+	n, err = this.ff1.cycleWalk(n,
+		new(big.Int).Lsh(big.NewInt(1), 122), T, enc)
+	if err != nil {
+		return "", err
+	}
+
+	// clear the free bits from the original value
+	// and scatter the encrypted ones in their place
+	for i := len(uuidFields) - 1; i >= 0; i-- {
+		f := uuidFields[i]
+
+		m := new(big.Int).Lsh(bitMask(f.bits), f.shift)
+		u.AndNot(u, m)
+
+		t.And(n, bitMask(f.bits))
+		u.Or(u, t.Lsh(t, f.shift))
+
+		n.Rsh(n, f.bits)
+	}
+
+	u.FillBytes(b)
+
+	s := hex.EncodeToString(b)
+	if strings.ToUpper(X) == X && strings.ToLower(X) != X {
+		s = strings.ToUpper(s)
+	}
+
+	return s[0:8] + "-" + s[8:12] + "-" + s[12:16] + "-" +
+		s[16:20] + "-" + s[20:32], nil
+}
+
+// Encrypt a uuid @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *UUID) Encrypt(X string, T []byte) (string, error) {
+	return this.cipher(X, T, true)
+}
+
+// Decrypt a uuid @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *UUID) Decrypt(X string, T []byte) (string, error) {
+	return this.cipher(X, T, false)
+}
@@ -0,0 +1,75 @@
+package ubiq
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUUID(t *testing.T) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	uuid, err := NewUUID(ff1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"123e4567-e89b-42d3-a456-426614174000",
+		"f47ac10b-58cc-4372-a567-0e02b2c3d479",
+		"018F3E2A-7B4C-7D5E-9F60-A1B2C3D4E5F6",
+		"00000000-0000-4000-8000-000000000000",
+		"ffffffff-ffff-7fff-bfff-ffffffffffff",
+	} {
+		CT, err := uuid.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(CT) != len(PT) || CT == PT {
+			t.Fatal(CT)
+		}
+		for _, i := range []int{8, 13, 18, 23} {
+			if CT[i] != '-' {
+				t.Fatal(CT)
+			}
+		}
+
+		// version and variant
+		if CT[14] != PT[14] ||
+			strings.IndexByte("89abAB", CT[19]) < 0 {
+			t.Fatal(CT)
+		}
+
+		if strings.ToLower(PT) != PT && strings.ToUpper(CT) != CT ||
+			strings.ToLower(PT) == PT && strings.ToLower(CT) != CT {
+			t.Fatal(CT)
+		}
+
+		out, err := uuid.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+}
+
+func TestUUIDInvalid(t *testing.T) {
+	ff1, _ := NewFF1(testMixedKey, nil, 0, 0, 10)
+	uuid, _ := NewUUID(ff1)
+
+	for _, PT := range []string{
+		"123e4567e89b42d3a456426614174000",
+		"123e4567-e89b-42d3-a456-42661417400g",
+		"123e4567-e89b-42d3-c456-426614174000",
+		"{123e4567-e89b-42d3-a456-426614174000}",
+	} {
+		if _, err := uuid.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
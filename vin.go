@@ -0,0 +1,147 @@
+package ubiq
+
+import (
+	"errors"
+)
+
+// the characters allowed in a vin; I, O, and Q are excluded
+var vinAlphabet, _ = NewAlphabet("0123456789ABCDEFGHJKLMNPRSTUVWXYZ")
+
+// the values of the letters for the purposes of computing the check
+// digit. the values of the digits are the digits themselves
+var vinValues = map[rune]int{
+	'A': 1, 'B': 2, 'C': 3, 'D': 4, 'E': 5, 'F': 6, 'G': 7, 'H': 8,
+	'J': 1, 'K': 2, 'L': 3, 'M': 4, 'N': 5, 'P': 7, 'R': 9,
+	'S': 2, 'T': 3, 'U': 4, 'V': 5, 'W': 6, 'X': 7, 'Y': 8, 'Z': 9,
+}
+
+var vinWeights = [17]int{8, 7, 6, 5, 4, 3, 2, 10, 0, 9, 8, 7, 6, 5, 4, 3, 2}
+
+// the position of the check digit within the vin
+const vinCheckPos = 8
+
+// compute the check digit of the vin @X, whose
+// characters must all be in the vin alphabet
+func vinCheck(X []rune) rune {
+	s := 0
+	for i, r := range X {
+		v := int(r - '0')
+		if r > '9' {
+			v = vinValues[r]
+		}
+		s += v * vinWeights[i]
+	}
+
+	if s%11 == 10 {
+		return 'X'
+	}
+	return rune('0' + s%11)
+}
+
+// Context structure for encrypting vehicle identification
+// numbers (VINs) such that the result is a valid VIN
+type VIN struct {
+	mr *MixedRadix
+
+	wmi bool
+}
+
+// Allocate a new VIN context structure
+//
+// @ff1 is the context used to perform the underlying encryption.
+// the radix and alphabet of the context are not used
+//
+// if @wmi is true, the world manufacturer identifier (the first
+// three characters) is left unchanged. the remaining characters,
+// except for the check digit, are encrypted using the alphabet of
+// characters allowed in a vin, and the check digit (the ninth
+// character) is then recomputed
+func NewVIN(ff1 *FF1, wmi bool) (*VIN, error) {
+	n := 16
+	if wmi {
+		n -= 3
+	}
+
+	args := make([]interface{}, n)
+	for i := range args {
+		args[i] = vinAlphabet
+	}
+
+	mr, err := NewMixedRadix(ff1, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &VIN{mr: mr, wmi: wmi}, nil
+}
+
+func (this *VIN) cipher(X []rune, T []byte, enc bool) ([]rune, error) {
+	if len(X) != 17 {
+		return nil, errors.New("invalid text length")
+	}
+
+	for _, r := range X {
+		if vinAlphabet.PosOf(r) < 0 {
+			return nil, errors.New("invalid character in input")
+		}
+	}
+
+	if vinCheck(X) != X[vinCheckPos] {
+		return nil, errors.New("invalid check digit")
+	}
+
+	s := 0
+	if this.wmi {
+		s = 3
+	}
+
+	// the encrypted characters, excluding the check digit
+	R := append(append([]rune{}, X[s:vinCheckPos]...),
+		X[vinCheckPos+1:]...)
+
+	R, err := this.mr.cipher(R, T, enc)
+	if err != nil {
+		return nil, err
+	}
+
+	Y := make([]rune, len(X))
+	copy(Y, X[:s])
+	copy(Y[s:], R[:vinCheckPos-s])
+	copy(Y[vinCheckPos+1:], R[vinCheckPos-s:])
+	Y[vinCheckPos] = vinCheck(Y)
+
+	return Y, nil
+}
+
+func (this *VIN) EncryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, true)
+}
+
+// Encrypt a vin @X with the tweak @T. the input must
+// carry a valid check digit
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *VIN) Encrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.EncryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
+
+func (this *VIN) DecryptRunes(X []rune, T []byte) ([]rune, error) {
+	return this.cipher(X, T, false)
+}
+
+// Decrypt a vin @X with the tweak @T
+//
+// @T may be nil, in which case the default tweak of the
+// underlying FF1 context will be used
+func (this *VIN) Decrypt(X string, T []byte) (Y string, err error) {
+	Yr, err := this.DecryptRunes([]rune(X), T)
+	if err == nil {
+		Y = string(Yr)
+	}
+	return Y, err
+}
@@ -0,0 +1,67 @@
+package ubiq
+
+import (
+	"testing"
+)
+
+func testVIN(t *testing.T, wmi bool) {
+	ff1, err := NewFF1(testMixedKey, nil, 0, 0, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	vin, err := NewVIN(ff1, wmi)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, PT := range []string{
+		"1HGCM82633A004352",
+		"1M8GDM9AXKP042788",
+		"11111111111111111",
+		"5YJ3E1EA2KF317000",
+	} {
+		CT, err := vin.Encrypt(PT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		if len(CT) != 17 || vinCheck([]rune(CT)) != rune(CT[vinCheckPos]) {
+			t.Fatal(CT)
+		}
+		for _, r := range CT {
+			if vinAlphabet.PosOf(r) < 0 {
+				t.Fatal(CT)
+			}
+		}
+		if wmi && CT[:3] != PT[:3] {
+			t.Fatal(CT)
+		}
+
+		out, err := vin.Decrypt(CT, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != PT {
+			t.Fatal(out + " != " + PT)
+		}
+	}
+
+	for _, PT := range []string{
+		"1HGCM82643A004352", // bad check digit
+		"1HGCM82633A00435",  // too short
+		"1HGCM82633A0O4352", // contains O
+	} {
+		if _, err := vin.Encrypt(PT, nil); err == nil {
+			t.Fatal(PT)
+		}
+	}
+}
+
+func TestVIN(t *testing.T) {
+	testVIN(t, false)
+}
+
+func TestVINPreserveWMI(t *testing.T) {
+	testVIN(t, true)
+}